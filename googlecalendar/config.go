@@ -1,10 +1,66 @@
 package googlecalendar
 
 import (
+	"context"
+	"strings"
+	"sync"
+
+	admin "google.golang.org/api/admin/directory/v1"
 	"google.golang.org/api/calendar/v3"
 )
 
 // Config is the structure used to instantiate the Google Calendar provider.
 type Config struct {
 	calendar *calendar.Service
+
+	// directory resolves email aliases to primary addresses. It's nil
+	// unless resolve_attendee_aliases is set.
+	directory *admin.Service
+
+	mu             sync.Mutex
+	primaryAddress map[string]string
+}
+
+// sameAddress reports whether a and b refer to the same person or group:
+// equal ignoring case or, when alias resolution is enabled, sharing a primary
+// address in the directory.
+func (c *Config) sameAddress(ctx context.Context, a, b string) bool {
+	if strings.EqualFold(a, b) {
+		return true
+	}
+	if c == nil || c.directory == nil {
+		return false
+	}
+	return c.primaryEmail(ctx, a) == c.primaryEmail(ctx, b)
+}
+
+// primaryEmail returns the lower-cased primary address of the user or group
+// that address belongs to, or address itself if the directory doesn't know
+// it - e.g. someone outside the organization. Lookups are cached for the life
+// of the provider.
+func (c *Config) primaryEmail(ctx context.Context, address string) string {
+	key := strings.ToLower(address)
+
+	c.mu.Lock()
+	primary, ok := c.primaryAddress[key]
+	c.mu.Unlock()
+	if ok {
+		return primary
+	}
+
+	primary = key
+	if user, err := c.directory.Users.Get(address).Fields("primaryEmail").Context(ctx).Do(); err == nil {
+		primary = strings.ToLower(user.PrimaryEmail)
+	} else if group, err := c.directory.Groups.Get(address).Fields("email").Context(ctx).Do(); err == nil {
+		primary = strings.ToLower(group.Email)
+	}
+
+	c.mu.Lock()
+	if c.primaryAddress == nil {
+		c.primaryAddress = map[string]string{}
+	}
+	c.primaryAddress[key] = primary
+	c.mu.Unlock()
+
+	return primary
 }
@@ -0,0 +1,296 @@
+package googlecalendar
+
+import (
+	"net/url"
+	"regexp"
+	"strings"
+	"unicode"
+
+	"golang.org/x/net/html"
+	"golang.org/x/net/html/atom"
+)
+
+// markdownInline matches the inline Markdown markdownToHTML understands: code
+// spans, links, autolinks, bold and italics.
+var markdownInline = regexp.MustCompile("`([^`]+)`" + `|\[([^\]]+)\]\(([^)\s]+)\)|<(https?://[^>\s]+)>|\*\*(.+?)\*\*|\*(.+?)\*`)
+
+// markdownListItem matches a bullet or numbered list item.
+var markdownListItem = regexp.MustCompile(`^(?:([-*+])|\d+[.)])\s+(.*)$`)
+
+// markdownHeading matches an ATX heading.
+var markdownHeading = regexp.MustCompile(`^#{1,6}\s+(.*?)\s*#*$`)
+
+// renderDescription returns the description to send to the API for the given
+// description_format.
+func renderDescription(description, format string) string {
+	if format == "markdown" {
+		return markdownToHTML(description)
+	}
+	return description
+}
+
+// descriptionMatches reports whether live, the description Google has stored,
+// says the same as configured once both are normalized. Google rewrites the
+// HTML it's given - and the web UI rewrites it again whenever someone edits
+// the event - so comparing the strings directly would show drift that isn't
+// there.
+func descriptionMatches(configured, live, format string) bool {
+	if configured == live {
+		return true
+	}
+
+	want := configured
+	switch format {
+	case "markdown":
+		want = markdownToHTML(configured)
+	case "plain":
+		want = html.EscapeString(configured)
+	}
+
+	return normalizeHTML(want) == normalizeHTML(live)
+}
+
+// markdownToHTML renders Markdown as the subset of HTML Google Calendar
+// displays: paragraphs and line breaks, bullet and numbered lists, links,
+// bold and italics. Headings are rendered bold, since Calendar has no
+// headings of its own, and code spans as plain text.
+func markdownToHTML(md string) string {
+	var b strings.Builder
+
+	const (
+		none = iota
+		text
+		gap
+		list
+	)
+	prev := none
+	openList := ""
+
+	closeList := func() {
+		if openList != "" {
+			b.WriteString("</" + openList + ">")
+			openList = ""
+		}
+	}
+
+	for _, line := range strings.Split(strings.ReplaceAll(md, "\r\n", "\n"), "\n") {
+		line = strings.TrimSpace(line)
+
+		if line == "" {
+			closeList()
+			if prev == text {
+				prev = gap
+			}
+			continue
+		}
+
+		if m := markdownListItem.FindStringSubmatch(line); m != nil {
+			tag := "ol"
+			if m[1] != "" {
+				tag = "ul"
+			}
+			if openList != tag {
+				closeList()
+				b.WriteString("<" + tag + ">")
+				openList = tag
+			}
+			b.WriteString("<li>" + markdownInlineToHTML(m[2]) + "</li>")
+			prev = list
+			continue
+		}
+		closeList()
+
+		switch prev {
+		case text:
+			b.WriteString("<br>")
+		case gap:
+			b.WriteString("<br><br>")
+		}
+
+		if m := markdownHeading.FindStringSubmatch(line); m != nil {
+			b.WriteString("<b>" + markdownInlineToHTML(m[1]) + "</b>")
+		} else {
+			b.WriteString(markdownInlineToHTML(line))
+		}
+		prev = text
+	}
+	closeList()
+
+	return b.String()
+}
+
+// markdownInlineToHTML renders the inline Markdown in a single line.
+func markdownInlineToHTML(s string) string {
+	var b strings.Builder
+
+	for s != "" {
+		m := markdownInline.FindStringSubmatchIndex(s)
+		if m == nil {
+			b.WriteString(html.EscapeString(s))
+			break
+		}
+		b.WriteString(html.EscapeString(s[:m[0]]))
+
+		group := func(i int) string { return s[m[2*i]:m[2*i+1]] }
+		switch {
+		case m[2] >= 0:
+			b.WriteString(html.EscapeString(group(1)))
+		case m[4] >= 0:
+			b.WriteString(`<a href="` + html.EscapeString(group(3)) + `">` + markdownInlineToHTML(group(2)) + "</a>")
+		case m[8] >= 0:
+			b.WriteString(`<a href="` + html.EscapeString(group(4)) + `">` + html.EscapeString(group(4)) + "</a>")
+		case m[10] >= 0:
+			b.WriteString("<b>" + markdownInlineToHTML(group(5)) + "</b>")
+		case m[12] >= 0:
+			b.WriteString("<i>" + markdownInlineToHTML(group(6)) + "</i>")
+		}
+
+		s = s[m[1]:]
+	}
+
+	return b.String()
+}
+
+// normalizeHTML reduces an event description to a canonical form for
+// comparison. Only what Calendar displays survives: text with runs of
+// whitespace collapsed, line breaks (however they were written, and with
+// blank lines dropped), lists, links - unwrapped from Google's redirector -
+// bold, italics and underlines. Anything that doesn't parse is returned as-is.
+func normalizeHTML(s string) string {
+	nodes, err := html.ParseFragment(strings.NewReader(s), &html.Node{
+		Type:     html.ElementNode,
+		Data:     "body",
+		DataAtom: atom.Body,
+	})
+	if err != nil {
+		return s
+	}
+
+	var n htmlNormalizer
+	for _, node := range nodes {
+		n.walk(node)
+	}
+
+	return n.b.String()
+}
+
+// htmlNormalizer accumulates the output of normalizeHTML. Breaks and spaces
+// are held back until the next content is written, so runs of them collapse
+// and none are left at either end.
+type htmlNormalizer struct {
+	b            strings.Builder
+	pendingBreak bool
+	pendingSpace bool
+}
+
+func (n *htmlNormalizer) lineBreak() {
+	n.pendingBreak = true
+	n.pendingSpace = false
+}
+
+func (n *htmlNormalizer) space() {
+	if !n.pendingBreak {
+		n.pendingSpace = true
+	}
+}
+
+func (n *htmlNormalizer) write(s string) {
+	if n.b.Len() > 0 {
+		switch {
+		case n.pendingBreak:
+			n.b.WriteByte('\n')
+		case n.pendingSpace:
+			n.b.WriteByte(' ')
+		}
+	}
+	n.pendingBreak, n.pendingSpace = false, false
+	n.b.WriteString(s)
+}
+
+func (n *htmlNormalizer) text(s string) {
+	word := strings.Builder{}
+	flush := func() {
+		if word.Len() > 0 {
+			n.write(html.EscapeString(word.String()))
+			word.Reset()
+		}
+	}
+
+	for _, r := range s {
+		switch {
+		case r == '\n':
+			flush()
+			n.lineBreak()
+		case unicode.IsSpace(r):
+			flush()
+			n.space()
+		default:
+			word.WriteRune(r)
+		}
+	}
+	flush()
+}
+
+func (n *htmlNormalizer) walk(node *html.Node) {
+	switch node.Type {
+	case html.TextNode:
+		n.text(node.Data)
+		return
+	case html.ElementNode:
+	default:
+		return
+	}
+
+	children := func() {
+		for c := node.FirstChild; c != nil; c = c.NextSibling {
+			n.walk(c)
+		}
+	}
+	wrap := func(open, close string) {
+		n.write(open)
+		children()
+		n.write(close)
+	}
+
+	switch node.DataAtom {
+	case atom.Br:
+		n.lineBreak()
+	case atom.B, atom.Strong:
+		wrap("<b>", "</b>")
+	case atom.I, atom.Em:
+		wrap("<i>", "</i>")
+	case atom.U:
+		wrap("<u>", "</u>")
+	case atom.A:
+		var href string
+		for _, a := range node.Attr {
+			if a.Key == "href" {
+				href = unwrapGoogleRedirect(a.Val)
+			}
+		}
+		wrap(`<a href="`+html.EscapeString(href)+`">`, "</a>")
+	case atom.Ul, atom.Ol, atom.Li:
+		n.lineBreak()
+		wrap("<"+node.Data+">", "</"+node.Data+">")
+		n.lineBreak()
+	case atom.P, atom.Div, atom.H1, atom.H2, atom.H3, atom.H4, atom.H5, atom.H6:
+		n.lineBreak()
+		children()
+		n.lineBreak()
+	default:
+		children()
+	}
+}
+
+// unwrapGoogleRedirect returns the destination of a link Google has routed
+// through its redirector (https://www.google.com/url?q=...), or href itself.
+func unwrapGoogleRedirect(href string) string {
+	u, err := url.Parse(href)
+	if err != nil || u.Host != "www.google.com" || u.Path != "/url" {
+		return href
+	}
+	if q := u.Query().Get("q"); q != "" {
+		return q
+	}
+	return href
+}
@@ -0,0 +1,81 @@
+package googlecalendar
+
+import "testing"
+
+func TestMarkdownToHTML(t *testing.T) {
+	md := "# Agenda\n" +
+		"Notes are in [the doc](https://example.com/doc?a=1&b=2).\n" +
+		"\n" +
+		"- **Intros**\n" +
+		"- Review *open* items\n" +
+		"\n" +
+		"1. Decide\n" +
+		"2. Wrap up at <https://example.com/wrap>\n" +
+		"\n" +
+		"Keep `snake_case` and 1 < 2 intact."
+
+	want := `<b>Agenda</b><br>Notes are in <a href="https://example.com/doc?a=1&amp;b=2">the doc</a>.` +
+		`<ul><li><b>Intros</b></li><li>Review <i>open</i> items</li></ul>` +
+		`<ol><li>Decide</li><li>Wrap up at <a href="https://example.com/wrap">https://example.com/wrap</a></li></ol>` +
+		`Keep snake_case and 1 &lt; 2 intact.`
+
+	if got := markdownToHTML(md); got != want {
+		t.Errorf("got  %s\nwant %s", got, want)
+	}
+}
+
+func TestDescriptionMatches(t *testing.T) {
+	cases := []struct {
+		name       string
+		configured string
+		live       string
+		format     string
+		want       bool
+	}{
+		{
+			name:       "plain text edited into HTML by the web UI",
+			configured: "Line one\nLine two",
+			live:       "Line one<br>Line two",
+			format:     "plain",
+			want:       true,
+		},
+		{
+			name:       "plain text with different words",
+			configured: "Line one\nLine two",
+			live:       "Line one<br>Line 2",
+			format:     "plain",
+			want:       false,
+		},
+		{
+			name:       "markdown rewritten with paragraphs, spans and redirected links",
+			configured: "**Agenda**\n\n- See [doc](https://example.com/doc)\n- Q&A",
+			live: `<p><strong>Agenda</strong></p><ul><li><span>See <a href="https://www.google.com/url?q=https://example.com/doc&amp;sa=D">doc</a></span></li>` +
+				`<li>Q&amp;A</li></ul>`,
+			format: "markdown",
+			want:   true,
+		},
+		{
+			name:       "markdown with a dropped list item",
+			configured: "- one\n- two",
+			live:       "<ul><li>one</li></ul>",
+			format:     "markdown",
+			want:       false,
+		},
+		{
+			name:       "html with nbsp and extra whitespace",
+			configured: "<b>Hi</b> there",
+			live:       "<b>Hi</b>&nbsp; there<br>",
+			format:     "html",
+			want:       true,
+		},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			if got := descriptionMatches(tc.configured, tc.live, tc.format); got != tc.want {
+				t.Errorf("got %t, want %t (normalized %q vs %q)", got, tc.want,
+					normalizeHTML(renderDescription(tc.configured, tc.format)), normalizeHTML(tc.live))
+			}
+		})
+	}
+}
@@ -0,0 +1,519 @@
+package googlecalendar
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	fwpath "github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringdefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"google.golang.org/api/calendar/v3"
+)
+
+// autoDeclineModes are the values the API accepts for an event's
+// autoDeclineMode.
+var autoDeclineModes = []string{
+	"declineNone",
+	"declineAllConflictingInvitations",
+	"declineOnlyNewConflictingInvitations",
+}
+
+// The attributes below are shared by every resource that manages a calendar
+// event, so that scheduling and deletion behave the same across event types.
+
+// idAttribute returns the schema for the Terraform resource ID.
+func idAttribute() schema.StringAttribute {
+	return schema.StringAttribute{
+		Description: "The Terraform resource ID.",
+		Computed:    true,
+		PlanModifiers: []planmodifier.String{
+			stringplanmodifier.UseStateForUnknown(),
+		},
+	}
+}
+
+// startAttribute returns the schema for an event's start time.
+func startAttribute() schema.StringAttribute {
+	return schema.StringAttribute{
+		Description: "The start time of the event in RFC3339 format. The UTC offset is optional; " +
+			"without one, the time is in the event's `timezone`.",
+		CustomType: dateTimeType{},
+		Required:   true,
+	}
+}
+
+// endAttribute returns the schema for an event's end time.
+func endAttribute() schema.StringAttribute {
+	return schema.StringAttribute{
+		Description: "The end time of the event in RFC3339 format. The UTC offset is optional; " +
+			"without one, the time is in the event's `timezone`.",
+		CustomType: dateTimeType{},
+		Required:   true,
+	}
+}
+
+// timezoneAttribute returns the schema for an event's time zone.
+func timezoneAttribute() schema.StringAttribute {
+	return schema.StringAttribute{
+		Description: "The time zone of the event.",
+		Required:    true,
+	}
+}
+
+// recurrenceAttribute returns the schema for an event's recurrence lines.
+func recurrenceAttribute() schema.ListAttribute {
+	return schema.ListAttribute{
+		Description: "List of RRULE, EXRULE, RDATE and EXDATE lines for a recurring event. Lines " +
+			"Google rewrites without changing the schedule - reordered parts, an added WKST, " +
+			"reformatted dates - aren't reported as changes.",
+		CustomType:  newRecurrenceType(),
+		ElementType: types.StringType,
+		Optional:    true,
+		Validators: []validator.List{
+			recurrenceValidator{},
+		},
+	}
+}
+
+// htmlLinkAttribute returns the schema for an event's link in the web UI.
+func htmlLinkAttribute() schema.StringAttribute {
+	return schema.StringAttribute{
+		Description: "An absolute link to the event in the Google Calendar Web UI.",
+		Computed:    true,
+		PlanModifiers: []planmodifier.String{
+			stringplanmodifier.UseStateForUnknown(),
+		},
+	}
+}
+
+// deletionPolicyAttribute returns the schema for what destroying an event
+// does to its recurring series.
+func deletionPolicyAttribute() schema.StringAttribute {
+	return schema.StringAttribute{
+		Description: "Behavior when this resource is destroyed (e.g. via `-replace`). " +
+			"`DELETE` (default) removes the entire recurring series, past and future. " +
+			"`TRUNCATE` caps the series with an UNTIL just before its next occurrence - " +
+			"equivalent to the calendar UI's \"this and following events\" delete - so past " +
+			"instances remain on the calendar.",
+		Optional: true,
+		Computed: true,
+		Default:  stringdefault.StaticString("DELETE"),
+		Validators: []validator.String{
+			stringvalidator.OneOf("DELETE", "TRUNCATE"),
+		},
+	}
+}
+
+// configureResource returns the provider's Config for a resource's
+// Configure, or nil before the provider has been configured.
+func configureResource(req resource.ConfigureRequest, resp *resource.ConfigureResponse) *Config {
+	if req.ProviderData == nil {
+		return nil
+	}
+
+	config, ok := req.ProviderData.(*Config)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *Config, got: %T", req.ProviderData),
+		)
+		return nil
+	}
+
+	return config
+}
+
+// buildEventTime sets event's start, end and recurrence from the given
+// attributes.
+func buildEventTime(ctx context.Context, start, end dateTimeValue, timezone types.String, recurrence recurrenceValue, event *calendar.Event) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	event.Start = &calendar.EventDateTime{
+		DateTime: start.ValueString(),
+		TimeZone: timezone.ValueString(),
+	}
+	event.End = &calendar.EventDateTime{
+		DateTime: end.ValueString(),
+		TimeZone: timezone.ValueString(),
+	}
+
+	if !recurrence.IsNull() {
+		var lines []string
+		diags = append(diags, recurrence.ElementsAs(ctx, &lines, false)...)
+		event.Recurrence = lines
+	}
+
+	return diags
+}
+
+// planTimeZoneChange keeps the configured start and end in the plan when the
+// time zone changes. Semantic equality compares a start or end without a UTC
+// offset with the prior state's by wall-clock time alone, so the plan would
+// otherwise keep the prior value, and its old offset, with the new zone.
+func planTimeZoneChange(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	if req.State.Raw.IsNull() || req.Plan.Raw.IsNull() {
+		return
+	}
+
+	var prior, planned types.String
+	resp.Diagnostics.Append(req.State.GetAttribute(ctx, fwpath.Root("timezone"), &prior)...)
+	resp.Diagnostics.Append(req.Plan.GetAttribute(ctx, fwpath.Root("timezone"), &planned)...)
+	if resp.Diagnostics.HasError() || planned.Equal(prior) {
+		return
+	}
+
+	for _, name := range []string{"start", "end"} {
+		var configured dateTimeValue
+		resp.Diagnostics.Append(req.Config.GetAttribute(ctx, fwpath.Root(name), &configured)...)
+		resp.Diagnostics.Append(resp.Plan.SetAttribute(ctx, fwpath.Root(name), configured)...)
+	}
+}
+
+// validateEventTime checks the time zone, and that start and end parse and
+// are the right way round, as every event resource does at plan time. The API
+// would reject them too, but only mid-apply. It returns start, the time zone
+// it's in and whether it's known and valid, for checks that build on them.
+func validateEventTime(start, end dateTimeValue, timezone types.String) (time.Time, *time.Location, bool, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	// Check the time zone, which offset-less start and end times are in
+	loc := time.UTC
+	if !timezone.IsNull() && !timezone.IsUnknown() {
+		var err error
+		loc, err = time.LoadLocation(timezone.ValueString())
+		if err != nil || timezone.ValueString() == "" {
+			diags.AddAttributeError(
+				fwpath.Root("timezone"),
+				"Invalid Time Zone",
+				fmt.Sprintf("%q is not an IANA time zone, such as \"America/New_York\".", timezone.ValueString()),
+			)
+			loc = time.UTC
+		}
+	}
+
+	// Check that start and end parse, and are the right way round
+	parse := func(attribute string, value dateTimeValue) (time.Time, bool) {
+		if value.IsNull() || value.IsUnknown() {
+			return time.Time{}, false
+		}
+		t, hasOffset, err := parseDateTime(value.ValueString())
+		if err != nil {
+			diags.AddAttributeError(
+				fwpath.Root(attribute),
+				"Invalid Date-Time",
+				fmt.Sprintf("%q is not an RFC3339 date-time, such as \"2023-12-27T20:00:00\" or "+
+					"\"2023-12-27T20:00:00-05:00\".", value.ValueString()),
+			)
+			return time.Time{}, false
+		}
+		if !hasOffset {
+			t = time.Date(t.Year(), t.Month(), t.Day(), t.Hour(), t.Minute(), t.Second(), t.Nanosecond(), loc)
+		}
+		return t, true
+	}
+	startTime, startOK := parse("start", start)
+	endTime, endOK := parse("end", end)
+	if startOK && endOK && !endTime.After(startTime) {
+		diags.AddAttributeError(
+			fwpath.Root("end"),
+			"Invalid Event Time Range",
+			fmt.Sprintf("end (%s) must be after start (%s).", end.ValueString(), start.ValueString()),
+		)
+	}
+
+	return startTime, loc, startOK, diags
+}
+
+// readEventTime updates the given attributes from event's start, end and
+// recurrence. Start and end are expressed in the event's time zone, rather
+// than the calendar's as the API returns them, so they compare equal to
+// configured values without a UTC offset (see dateTimeValue).
+func readEventTime(event *calendar.Event, start, end *dateTimeValue, timezone *types.String, recurrence *recurrenceValue) {
+	if event.Start != nil {
+		*start = newDateTimeValue(inTimeZone(event.Start.DateTime, event.Start.TimeZone))
+		*timezone = types.StringValue(event.Start.TimeZone)
+	}
+	if event.End != nil {
+		*end = newDateTimeValue(inTimeZone(event.End.DateTime, event.End.TimeZone))
+	}
+
+	if len(event.Recurrence) > 0 {
+		*recurrence = newRecurrenceValue(event.Recurrence)
+	} else {
+		*recurrence = newRecurrenceNull()
+	}
+}
+
+// optionalString converts an API string into an optional attribute value,
+// treating the empty string as null.
+func optionalString(s string) types.String {
+	if s == "" {
+		return types.StringNull()
+	}
+	return types.StringValue(s)
+}
+
+// deleteEvent deletes the event with the given id. If deletionPolicy is
+// "TRUNCATE" and the event recurs, the underlying series is capped just
+// before its next occurrence instead of being deleted outright - equivalent
+// to the calendar UI's "this and following events" delete - so past instances
+// stay on the calendar. Either way, Terraform drops the resource from state
+// once Delete returns without error; that part isn't conditional on what the
+// API call underneath actually did.
+//
+// sendUpdates is passed through to the API: "all", "externalOnly" or "none".
+// maxAttendees caps the attendees read back before truncating, as elsewhere.
+func deleteEvent(ctx context.Context, svc *calendar.Service, id, deletionPolicy string, recurring bool, sendUpdates string, maxAttendees int64) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	if deletionPolicy == "TRUNCATE" && recurring {
+		truncated, err := truncateRecurrence(ctx, svc, id, sendUpdates, maxAttendees)
+		if err != nil {
+			diags.AddError(
+				"Error truncating event",
+				fmt.Sprintf("Could not truncate event %s: %s", id, err),
+			)
+			return diags
+		}
+		if truncated {
+			return diags
+		}
+		// No future occurrence found - the series has already run its
+		// course, so there's nothing left to preserve. Fall through to a
+		// normal delete.
+	}
+
+	// Delete the event via API
+	err := svc.Events.
+		Delete("primary", id).
+		SendUpdates(sendUpdates).
+		Do()
+	if err != nil {
+		diags.AddError(
+			"Error deleting event",
+			fmt.Sprintf("Could not delete event %s: %s", id, err),
+		)
+	}
+
+	return diags
+}
+
+// truncateRecurrence caps a recurring event's RRULEs at its next occurrence,
+// rather than deleting it, so past instances remain visible on the calendar.
+// It reports false (with no error) when the series has no future occurrence
+// left to cap.
+func truncateRecurrence(ctx context.Context, svc *calendar.Service, id string, sendUpdates string, maxAttendees int64) (bool, error) {
+
+	event, err := svc.Events.Get("primary", id).MaxAttendees(maxAttendees).Do()
+	if err != nil {
+		return false, fmt.Errorf("reading event: %w", err)
+	}
+	if event.AttendeesOmitted {
+		return false, fmt.Errorf("the API returned a truncated attendee list, which updating the recurrence would write back")
+	}
+
+	boundary, err := nextOccurrenceBoundary(event.Recurrence, event.Start)
+	if err != nil {
+		return false, fmt.Errorf("computing recurrence boundary: %w", err)
+	}
+	if boundary == nil {
+		return false, nil
+	}
+
+	event.Recurrence = capRecurrenceUntil(event.Recurrence, *boundary)
+
+	_, err = svc.Events.
+		Update("primary", id, event).
+		SendUpdates(sendUpdates).
+		Do()
+	if err != nil {
+		return false, fmt.Errorf("updating recurrence: %w", err)
+	}
+
+	return true, nil
+}
+
+// typedEventModel is implemented by the models of the resources managing a
+// single type of event - out of office, focus time and working location -
+// giving eventKind the attributes they have in common.
+type typedEventModel interface {
+	// id returns the model's Terraform resource ID.
+	id() *types.String
+	// deletion returns the model's deletion_policy and whether it recurs.
+	deletion() (string, bool)
+}
+
+// eventKind implements the create, read, update, delete and import shared by
+// the resources managing a single type of event. M is the resource's model.
+type eventKind[M any, P interface {
+	*M
+	typedEventModel
+}] struct {
+	// eventType is the API's eventType, e.g. "outOfOffice".
+	eventType string
+	// name describes the event in messages, e.g. "out of office event".
+	name string
+	// build sets the event's fields from the model.
+	build func(ctx context.Context, model P, event *calendar.Event) diag.Diagnostics
+	// read updates the model from the event.
+	read func(model P, event *calendar.Event)
+}
+
+// create creates the event and sets the initial Terraform state.
+func (k eventKind[M, P]) create(ctx context.Context, svc *calendar.Service, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var plan M
+
+	// Read Terraform plan data into the model
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Build the event
+	event := &calendar.Event{EventType: k.eventType}
+	resp.Diagnostics.Append(k.build(ctx, &plan, event)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Create the event via API
+	eventAPI, err := svc.Events.
+		Insert("primary", event).
+		Do()
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error creating "+k.name,
+			fmt.Sprintf("Could not create %s: %s", k.name, err),
+		)
+		return
+	}
+
+	// Set the ID and populate computed fields
+	*P(&plan).id() = types.StringValue(eventAPI.Id)
+	k.read(&plan, eventAPI)
+
+	// Set state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+}
+
+// readState refreshes the Terraform state with the latest data.
+func (k eventKind[M, P]) readState(ctx context.Context, svc *calendar.Service, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var state M
+
+	// Read Terraform prior state data into the model
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Get the event from the API
+	id := P(&state).id().ValueString()
+	event, err := svc.Events.
+		Get("primary", id).
+		Do()
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error reading "+k.name,
+			fmt.Sprintf("Could not read %s %s: %s", k.name, id, err),
+		)
+		return
+	}
+
+	// Update the state with the API data
+	k.read(&state, event)
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+}
+
+// update updates the event and sets the updated Terraform state on success.
+func (k eventKind[M, P]) update(ctx context.Context, svc *calendar.Service, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var plan M
+
+	// Read Terraform plan data into the model
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Get the current event from the API
+	id := P(&plan).id().ValueString()
+	event, err := svc.Events.
+		Get("primary", id).
+		Do()
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error reading "+k.name+" for update",
+			fmt.Sprintf("Could not read %s %s: %s", k.name, id, err),
+		)
+		return
+	}
+
+	// Build the updated event
+	resp.Diagnostics.Append(k.build(ctx, &plan, event)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Update the event via API
+	eventAPI, err := svc.Events.
+		Update("primary", id, event).
+		Do()
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error updating "+k.name,
+			fmt.Sprintf("Could not update %s %s: %s", k.name, id, err),
+		)
+		return
+	}
+
+	// Update the state with the API data
+	k.read(&plan, eventAPI)
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+}
+
+// delete deletes the event, honoring deletion_policy (see deleteEvent).
+// These events have no attendees, so there's no one to notify.
+func (k eventKind[M, P]) delete(ctx context.Context, svc *calendar.Service, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var state M
+
+	// Read Terraform prior state data into the model
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	policy, recurring := P(&state).deletion()
+	resp.Diagnostics.Append(deleteEvent(ctx, svc, P(&state).id().ValueString(), policy, recurring, "none", defaultMaxAttendees)...)
+}
+
+// importState imports an existing event by its Google Calendar event ID,
+// refusing events of any other type.
+func (k eventKind[M, P]) importState(ctx context.Context, svc *calendar.Service, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	event, err := svc.Events.
+		Get("primary", req.ID).
+		Do()
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error importing "+k.name,
+			fmt.Sprintf("Could not read %s %s: %s", k.name, req.ID, err),
+		)
+		return
+	}
+	if event.EventType != k.eventType {
+		resp.Diagnostics.AddError(
+			"Unexpected event type",
+			fmt.Sprintf("Event %s is a %q event, not %q, so it can't be imported as a %s.",
+				req.ID, event.EventType, k.eventType, k.name),
+		)
+		return
+	}
+
+	resource.ImportStatePassthroughID(ctx, fwpath.Root("id"), req, resp)
+}
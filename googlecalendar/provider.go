@@ -4,13 +4,17 @@ package googlecalendar
 import (
 	"context"
 	"fmt"
+	"os"
 	"runtime"
 
 	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/provider"
 	"github.com/hashicorp/terraform-plugin-framework/provider/schema"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/types"
+	"golang.org/x/oauth2/google"
+	admin "google.golang.org/api/admin/directory/v1"
 	"google.golang.org/api/calendar/v3"
 	"google.golang.org/api/option"
 )
@@ -25,7 +29,9 @@ type googleCalendarProvider struct {
 
 // googleCalendarProviderModel describes the provider data model.
 type googleCalendarProviderModel struct {
-	Credentials types.String `tfsdk:"credentials"`
+	Credentials            types.String `tfsdk:"credentials"`
+	ResolveAttendeeAliases types.Bool   `tfsdk:"resolve_attendee_aliases"`
+	ImpersonatedUser       types.String `tfsdk:"impersonated_user"`
 }
 
 // New creates a new provider instance.
@@ -48,6 +54,20 @@ func (p *googleCalendarProvider) Schema(ctx context.Context, req provider.Schema
 				Description: "Google Cloud credentials JSON. Can also be set via GOOGLE_CREDENTIALS, GOOGLE_CLOUD_KEYFILE_JSON, or GCLOUD_KEYFILE_JSON environment variables.",
 				Optional:    true,
 			},
+			"resolve_attendee_aliases": schema.BoolAttribute{
+				Description: "Whether to resolve attendee email aliases to primary addresses through the " +
+					"Admin SDK Directory API, so an attendee configured by alias doesn't show up as a " +
+					"diff once the calendar reports their primary address. Requires the " +
+					"admin.directory.user.readonly and admin.directory.group.readonly scopes.",
+				Optional: true,
+			},
+			"impersonated_user": schema.StringAttribute{
+				Description: "Email of the Workspace user to make Directory API lookups as, through " +
+					"domain-wide delegation, when resolve_attendee_aliases is set. The Directory API " +
+					"rejects service accounts acting as themselves, so this is required when " +
+					"credentials is a service account key.",
+				Optional: true,
+			},
 		},
 	}
 }
@@ -64,11 +84,9 @@ func (p *googleCalendarProvider) Configure(ctx context.Context, req provider.Con
 	var opts []option.ClientOption
 
 	// Add credential source
-	if !config.Credentials.IsNull() && !config.Credentials.IsUnknown() {
-		credentials := config.Credentials.ValueString()
-		if credentials != "" {
-			opts = append(opts, option.WithCredentialsJSON([]byte(credentials)))
-		}
+	credentials := config.Credentials.ValueString()
+	if credentials != "" {
+		opts = append(opts, option.WithCredentialsJSON([]byte(credentials)))
 	}
 
 	// Use a custom user-agent string
@@ -91,16 +109,94 @@ func (p *googleCalendarProvider) Configure(ctx context.Context, req provider.Con
 	}
 	calendarSvc.UserAgent = userAgent
 
-	// Make the calendar service available to resources and data sources
-	resp.ResourceData = &Config{
+	providerConfig := &Config{
 		calendar: calendarSvc,
 	}
+
+	// Create the directory service, if alias resolution is enabled
+	if config.ResolveAttendeeAliases.ValueBool() {
+		scopes := []string{
+			admin.AdminDirectoryUserReadonlyScope,
+			admin.AdminDirectoryGroupReadonlyScope,
+		}
+		directoryOpts := append(opts, option.WithScopes(scopes...))
+
+		// Act as the impersonated user, signing for them with the service
+		// account key
+		if subject := config.ImpersonatedUser.ValueString(); subject != "" {
+			key := serviceAccountKey(ctx, credentials, scopes)
+			if key == nil {
+				resp.Diagnostics.AddAttributeError(
+					path.Root("impersonated_user"),
+					"Missing service account credentials",
+					"impersonated_user requires a service account key, set through credentials, "+
+						"GOOGLE_CREDENTIALS, GOOGLE_CLOUD_KEYFILE_JSON, GCLOUD_KEYFILE_JSON or "+
+						"application default credentials.",
+				)
+				return
+			}
+			jwtConfig, err := google.JWTConfigFromJSON(key, scopes...)
+			if err != nil {
+				resp.Diagnostics.AddAttributeError(
+					path.Root("impersonated_user"),
+					"Invalid service account credentials",
+					fmt.Sprintf("impersonated_user requires credentials to be a service account key: %s", err),
+				)
+				return
+			}
+			jwtConfig.Subject = subject
+			directoryOpts = []option.ClientOption{
+				option.WithTokenSource(jwtConfig.TokenSource(ctx)),
+				option.WithUserAgent(userAgent),
+			}
+		}
+
+		directorySvc, err := admin.NewService(ctx, directoryOpts...)
+		if err != nil {
+			resp.Diagnostics.AddError(
+				"Unable to create Admin SDK Directory API client",
+				fmt.Sprintf("Failed to create directory service: %s", err),
+			)
+			return
+		}
+		directorySvc.UserAgent = userAgent
+		providerConfig.directory = directorySvc
+	}
+
+	// Make the calendar service available to resources and data sources
+	resp.ResourceData = providerConfig
+}
+
+// credentialsEnvVars are the environment variables credentials can be set
+// through, in order of precedence.
+var credentialsEnvVars = []string{"GOOGLE_CREDENTIALS", "GOOGLE_CLOUD_KEYFILE_JSON", "GCLOUD_KEYFILE_JSON"}
+
+// serviceAccountKey returns the credentials JSON to sign impersonated
+// requests with: the credentials attribute, else the first of
+// credentialsEnvVars that is set, else the application default credentials.
+// It returns nil if none is found.
+func serviceAccountKey(ctx context.Context, credentials string, scopes []string) []byte {
+	if credentials != "" {
+		return []byte(credentials)
+	}
+	for _, name := range credentialsEnvVars {
+		if v := os.Getenv(name); v != "" {
+			return []byte(v)
+		}
+	}
+	if creds, err := google.FindDefaultCredentials(ctx, scopes...); err == nil && len(creds.JSON) > 0 {
+		return creds.JSON
+	}
+	return nil
 }
 
 // Resources returns the provider's resources.
 func (p *googleCalendarProvider) Resources(ctx context.Context) []func() resource.Resource {
 	return []func() resource.Resource{
 		NewEventResource,
+		NewOutOfOfficeResource,
+		NewFocusTimeResource,
+		NewWorkingLocationResource,
 	}
 }
 
@@ -0,0 +1,25 @@
+package googlecalendar
+
+import (
+	"context"
+	"testing"
+)
+
+func TestServiceAccountKey(t *testing.T) {
+	ctx := context.Background()
+	for _, name := range credentialsEnvVars {
+		t.Setenv(name, "")
+	}
+
+	t.Setenv("GCLOUD_KEYFILE_JSON", "gcloud")
+	if got := string(serviceAccountKey(ctx, "", nil)); got != "gcloud" {
+		t.Errorf("got %q, want the GCLOUD_KEYFILE_JSON key", got)
+	}
+	t.Setenv("GOOGLE_CREDENTIALS", "google")
+	if got := string(serviceAccountKey(ctx, "", nil)); got != "google" {
+		t.Errorf("got %q, want GOOGLE_CREDENTIALS to take precedence", got)
+	}
+	if got := string(serviceAccountKey(ctx, "configured", nil)); got != "configured" {
+		t.Errorf("got %q, want the credentials attribute to take precedence", got)
+	}
+}
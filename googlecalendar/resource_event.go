@@ -2,11 +2,19 @@ package googlecalendar
 
 import (
 	"context"
+	"crypto/rand"
+	"encoding/json"
 	"fmt"
 	"path"
+	"regexp"
+	"slices"
 	"strings"
 	"time"
 
+	"github.com/hashicorp/terraform-plugin-framework-validators/boolvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/listvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/resourcevalidator"
 	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
 	"github.com/hashicorp/terraform-plugin-framework/attr"
 	"github.com/hashicorp/terraform-plugin-framework/diag"
@@ -14,19 +22,26 @@ import (
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64default"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringdefault"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
 	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-framework/types/basetypes"
+	"github.com/hashicorp/terraform-plugin-go/tfprotov6"
 	"github.com/teambition/rrule-go"
 	"google.golang.org/api/calendar/v3"
 )
 
 // Ensure the implementation satisfies the expected interfaces.
 var (
-	_ resource.Resource                = &eventResource{}
-	_ resource.ResourceWithImportState = &eventResource{}
+	_ resource.Resource                     = &eventResource{}
+	_ resource.ResourceWithImportState      = &eventResource{}
+	_ resource.ResourceWithUpgradeState     = &eventResource{}
+	_ resource.ResourceWithConfigValidators = &eventResource{}
+	_ resource.ResourceWithValidateConfig   = &eventResource{}
+	_ resource.ResourceWithModifyPlan       = &eventResource{}
 )
 
 // eventResource is the resource implementation.
@@ -36,34 +51,117 @@ type eventResource struct {
 
 // eventResourceModel describes the resource data model.
 type eventResourceModel struct {
-	ID                      types.String `tfsdk:"id"`
-	Summary                 types.String `tfsdk:"summary"`
-	Location                types.String `tfsdk:"location"`
-	Description             types.String `tfsdk:"description"`
-	Start                   types.String `tfsdk:"start"`
-	End                     types.String `tfsdk:"end"`
-	Timezone                types.String `tfsdk:"timezone"`
-	GuestsCanInviteOthers   types.Bool   `tfsdk:"guests_can_invite_others"`
-	GuestsCanModify         types.Bool   `tfsdk:"guests_can_modify"`
-	GuestsCanSeeOtherGuests types.Bool   `tfsdk:"guests_can_see_other_guests"`
-	ShowAsAvailable         types.Bool   `tfsdk:"show_as_available"`
-	SendNotifications       types.Bool   `tfsdk:"send_notifications"`
-	Visibility              types.String `tfsdk:"visibility"`
-	Recurrence              types.List   `tfsdk:"recurrence"`
-	Conference              types.Map    `tfsdk:"conference"`
-	Attendees               types.Set    `tfsdk:"attendee"`
-	Attachments             types.Set    `tfsdk:"attachment"`
-	HTMLLink                types.String `tfsdk:"html_link"`
-	DeletionPolicy          types.String `tfsdk:"deletion_policy"`
-	AutoReconcile           types.Bool   `tfsdk:"auto_reconcile"`
+	ID                      types.String    `tfsdk:"id"`
+	Summary                 types.String    `tfsdk:"summary"`
+	Location                types.String    `tfsdk:"location"`
+	Description             types.String    `tfsdk:"description"`
+	DescriptionFormat       types.String    `tfsdk:"description_format"`
+	Start                   dateTimeValue   `tfsdk:"start"`
+	End                     dateTimeValue   `tfsdk:"end"`
+	Timezone                types.String    `tfsdk:"timezone"`
+	GuestsCanInviteOthers   types.Bool      `tfsdk:"guests_can_invite_others"`
+	GuestsCanModify         types.Bool      `tfsdk:"guests_can_modify"`
+	GuestsCanSeeOtherGuests types.Bool      `tfsdk:"guests_can_see_other_guests"`
+	ShowAsAvailable         types.Bool      `tfsdk:"show_as_available"`
+	SendNotifications       types.Bool      `tfsdk:"send_notifications"`
+	SendUpdates             types.String    `tfsdk:"send_updates"`
+	Visibility              types.String    `tfsdk:"visibility"`
+	Recurrence              recurrenceValue `tfsdk:"recurrence"`
+	Schedule                types.Object    `tfsdk:"schedule"`
+	Skips                   types.Set       `tfsdk:"skip"`
+	SkipHolidaysCalendar    types.String    `tfsdk:"skip_holidays_calendar"`
+	SkipHolidaysHorizonDays types.Int64     `tfsdk:"skip_holidays_horizon_days"`
+	SkippedHolidays         types.List      `tfsdk:"skipped_holidays"`
+	SkipOutOfOffice         types.Bool      `tfsdk:"skip_when_attendee_out_of_office"`
+	OutOfOfficeLookahead    types.Int64     `tfsdk:"out_of_office_lookahead"`
+	SkippedOutOfOffice      types.List      `tfsdk:"skipped_out_of_office"`
+	EndsOn                  types.String    `tfsdk:"ends_on"`
+	UpcomingCount           types.Int64     `tfsdk:"upcoming_occurrences_count"`
+	NextOccurrence          types.String    `tfsdk:"next_occurrence"`
+	UpcomingOccurrences     types.List      `tfsdk:"upcoming_occurrences"`
+	LastOccurrence          types.String    `tfsdk:"last_occurrence"`
+	Conference              types.Object    `tfsdk:"conference"`
+	GoogleMeetID            types.String    `tfsdk:"google_meet_id"`
+	GoogleMeetURI           types.String    `tfsdk:"google_meet_uri"`
+	Attendees               types.Set       `tfsdk:"attendee"`
+	Rooms                   types.Set       `tfsdk:"room"`
+	MaxAttendees            types.Int64     `tfsdk:"max_attendees"`
+	RoomResponseTimeout     types.String    `tfsdk:"room_response_timeout"`
+	Attachments             types.Set       `tfsdk:"attachment"`
+	ExtendedProperties      types.Object    `tfsdk:"extended_properties"`
+	Source                  types.Object    `tfsdk:"source"`
+	HTMLLink                types.String    `tfsdk:"html_link"`
+	ICalUID                 types.String    `tfsdk:"ical_uid"`
+	Etag                    types.String    `tfsdk:"etag"`
+	Created                 types.String    `tfsdk:"created"`
+	Updated                 types.String    `tfsdk:"updated"`
+	OrganizerEmail          types.String    `tfsdk:"organizer_email"`
+	CreatorEmail            types.String    `tfsdk:"creator_email"`
+	Status                  types.String    `tfsdk:"status"`
+	HangoutLink             types.String    `tfsdk:"hangout_link"`
+	RecurringEventID        types.String    `tfsdk:"recurring_event_id"`
+	DeletionPolicy          types.String    `tfsdk:"deletion_policy"`
+	AutoReconcile           types.Bool      `tfsdk:"auto_reconcile"`
 }
 
 // attendeeModel describes the attendee nested object.
 type attendeeModel struct {
-	Email    types.String `tfsdk:"email"`
-	Optional types.Bool   `tfsdk:"optional"`
+	Email            emailValue   `tfsdk:"email"`
+	Optional         types.Bool   `tfsdk:"optional"`
+	DisplayName      types.String `tfsdk:"display_name"`
+	Comment          types.String `tfsdk:"comment"`
+	AdditionalGuests types.Int64  `tfsdk:"additional_guests"`
+	ResponseStatus   types.String `tfsdk:"response_status"`
+	Organizer        types.Bool   `tfsdk:"organizer"`
+	Self             types.Bool   `tfsdk:"self"`
+	Resource         types.Bool   `tfsdk:"resource"`
 }
 
+// attendeeAttrTypes are the attribute types of the attendee nested object.
+var attendeeAttrTypes = map[string]attr.Type{
+	"email":             emailType{},
+	"optional":          types.BoolType,
+	"display_name":      types.StringType,
+	"comment":           types.StringType,
+	"additional_guests": types.Int64Type,
+	"response_status":   types.StringType,
+	"organizer":         types.BoolType,
+	"self":              types.BoolType,
+	"resource":          types.BoolType,
+}
+
+// roomModel describes the room nested object.
+type roomModel struct {
+	Email          emailValue   `tfsdk:"email"`
+	ResponseStatus types.String `tfsdk:"response_status"`
+}
+
+// roomAttrTypes are the attribute types of the room nested object.
+var roomAttrTypes = map[string]attr.Type{
+	"email":           emailType{},
+	"response_status": types.StringType,
+}
+
+// defaultMaxAttendees is the number of attendees requested from the API when
+// max_attendees isn't known, e.g. in state written before it existed.
+const defaultMaxAttendees = 25
+
+// defaultUpcomingOccurrences is the number of upcoming occurrences projected
+// when upcoming_occurrences_count isn't known.
+const defaultUpcomingOccurrences = 5
+
+// defaultHolidayHorizonDays is how far ahead skip_holidays_calendar is
+// searched for holidays by default.
+const defaultHolidayHorizonDays = 365
+
+// defaultOutOfOfficeLookahead is how many upcoming occurrences
+// skip_when_attendee_out_of_office checks by default.
+const defaultOutOfOfficeLookahead = 10
+
+// roomPollInterval is how often Create and Update check whether the event's
+// rooms have responded.
+const roomPollInterval = 5 * time.Second
+
 // attachmentModel describes the attachment nested object.
 type attachmentModel struct {
 	FileURL  types.String `tfsdk:"file_url"`
@@ -71,6 +169,122 @@ type attachmentModel struct {
 	Title    types.String `tfsdk:"title"`
 }
 
+// extendedPropertiesModel describes the extended_properties nested object.
+type extendedPropertiesModel struct {
+	Private types.Map `tfsdk:"private"`
+	Shared  types.Map `tfsdk:"shared"`
+}
+
+// extendedPropertiesAttrTypes are the attribute types of the
+// extended_properties nested object.
+var extendedPropertiesAttrTypes = map[string]attr.Type{
+	"private": types.MapType{ElemType: types.StringType},
+	"shared":  types.MapType{ElemType: types.StringType},
+}
+
+// sourceModel describes the source nested object.
+type sourceModel struct {
+	Title types.String `tfsdk:"title"`
+	URL   types.String `tfsdk:"url"`
+}
+
+// sourceAttrTypes are the attribute types of the source nested object.
+var sourceAttrTypes = map[string]attr.Type{
+	"title": types.StringType,
+	"url":   types.StringType,
+}
+
+// scheduleModel describes the schedule nested object.
+type scheduleModel struct {
+	Frequency  types.String `tfsdk:"frequency"`
+	Interval   types.Int64  `tfsdk:"interval"`
+	ByDay      types.List   `tfsdk:"by_day"`
+	ByMonthDay types.List   `tfsdk:"by_month_day"`
+	BySetPos   types.List   `tfsdk:"by_set_pos"`
+	Count      types.Int64  `tfsdk:"count"`
+	Until      types.String `tfsdk:"until"`
+	WeekStart  types.String `tfsdk:"week_start"`
+}
+
+// scheduleAttrTypes are the attribute types of the schedule nested object.
+var scheduleAttrTypes = map[string]attr.Type{
+	"frequency":    types.StringType,
+	"interval":     types.Int64Type,
+	"by_day":       types.ListType{ElemType: types.StringType},
+	"by_month_day": types.ListType{ElemType: types.Int64Type},
+	"by_set_pos":   types.ListType{ElemType: types.Int64Type},
+	"count":        types.Int64Type,
+	"until":        types.StringType,
+	"week_start":   types.StringType,
+}
+
+// skipModel describes the skip nested object.
+type skipModel struct {
+	Date types.String `tfsdk:"date"`
+	From types.String `tfsdk:"from"`
+	To   types.String `tfsdk:"to"`
+}
+
+// skipAttrTypes are the attribute types of the skip nested object.
+var skipAttrTypes = map[string]attr.Type{
+	"date": types.StringType,
+	"from": types.StringType,
+	"to":   types.StringType,
+}
+
+// googleMeetIDPattern matches a Google Meet ID, e.g. aaa-bbbb-ccc.
+var googleMeetIDPattern = regexp.MustCompile(`^[a-z]{3}-[a-z]{4}-[a-z]{3}$`)
+
+// weekdays are the RFC 5545 two-letter weekday codes.
+var weekdays = []string{"MO", "TU", "WE", "TH", "FR", "SA", "SU"}
+
+// conferenceModel describes the conference nested object.
+type conferenceModel struct {
+	SolutionType     types.String `tfsdk:"solution_type"`
+	SolutionName     types.String `tfsdk:"solution_name"`
+	ConferenceID     types.String `tfsdk:"conference_id"`
+	CreateGoogleMeet types.Bool   `tfsdk:"create_google_meet"`
+	Notes            types.String `tfsdk:"notes"`
+	EntryPoints      types.List   `tfsdk:"entry_points"`
+}
+
+// conferenceAttrTypes are the attribute types of the conference nested
+// object.
+var conferenceAttrTypes = map[string]attr.Type{
+	"solution_type":      types.StringType,
+	"solution_name":      types.StringType,
+	"conference_id":      types.StringType,
+	"create_google_meet": types.BoolType,
+	"notes":              types.StringType,
+	"entry_points":       types.ListType{ElemType: types.ObjectType{AttrTypes: entryPointAttrTypes}},
+}
+
+// entryPointModel describes a conference entry point.
+type entryPointModel struct {
+	EntryPointType types.String `tfsdk:"entry_point_type"`
+	URI            types.String `tfsdk:"uri"`
+	Label          types.String `tfsdk:"label"`
+	Pin            types.String `tfsdk:"pin"`
+	Passcode       types.String `tfsdk:"passcode"`
+	AccessCode     types.String `tfsdk:"access_code"`
+	MeetingCode    types.String `tfsdk:"meeting_code"`
+	Password       types.String `tfsdk:"password"`
+	RegionCode     types.String `tfsdk:"region_code"`
+}
+
+// entryPointAttrTypes are the attribute types of a conference entry point.
+var entryPointAttrTypes = map[string]attr.Type{
+	"entry_point_type": types.StringType,
+	"uri":              types.StringType,
+	"label":            types.StringType,
+	"pin":              types.StringType,
+	"passcode":         types.StringType,
+	"access_code":      types.StringType,
+	"meeting_code":     types.StringType,
+	"password":         types.StringType,
+	"region_code":      types.StringType,
+}
+
 // NewEventResource creates a new event resource.
 func NewEventResource() resource.Resource {
 	return &eventResource{}
@@ -85,14 +299,9 @@ func (r *eventResource) Metadata(ctx context.Context, req resource.MetadataReque
 func (r *eventResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
 	resp.Schema = schema.Schema{
 		Description: "Manages a Google Calendar event.",
+		Version:     1,
 		Attributes: map[string]schema.Attribute{
-			"id": schema.StringAttribute{
-				Description: "The Terraform resource ID.",
-				Computed:    true,
-				PlanModifiers: []planmodifier.String{
-					stringplanmodifier.UseStateForUnknown(),
-				},
-			},
+			"id": idAttribute(),
 			"summary": schema.StringAttribute{
 				Description: "The summary or title of the event.",
 				Required:    true,
@@ -102,21 +311,24 @@ func (r *eventResource) Schema(ctx context.Context, req resource.SchemaRequest,
 				Optional:    true,
 			},
 			"description": schema.StringAttribute{
-				Description: "Description of the event.",
+				Description: "Description of the event, written in `description_format`.",
 				Optional:    true,
 			},
-			"start": schema.StringAttribute{
-				Description: "The start time of the event in RFC3339 format.",
-				Required:    true,
-			},
-			"end": schema.StringAttribute{
-				Description: "The end time of the event in RFC3339 format.",
-				Required:    true,
-			},
-			"timezone": schema.StringAttribute{
-				Description: "The time zone of the event.",
-				Required:    true,
+			"description_format": schema.StringAttribute{
+				Description: "How `description` is written: `plain` text (the default), `html`, or " +
+					"`markdown`, which is rendered to the HTML Calendar displays. Google rewrites the " +
+					"HTML it stores, so the stored description is compared with the configured one " +
+					"after normalizing both, and only differences in what Calendar shows are reported.",
+				Optional: true,
+				Computed: true,
+				Default:  stringdefault.StaticString("plain"),
+				Validators: []validator.String{
+					stringvalidator.OneOf("plain", "html", "markdown"),
+				},
 			},
+			"start":    startAttribute(),
+			"end":      endAttribute(),
+			"timezone": timezoneAttribute(),
 			"guests_can_invite_others": schema.BoolAttribute{
 				Description: "Whether attendees can invite others to the event.",
 				Optional:    true,
@@ -142,10 +354,23 @@ func (r *eventResource) Schema(ctx context.Context, req resource.SchemaRequest,
 				Default:     booldefault.StaticBool(false),
 			},
 			"send_notifications": schema.BoolAttribute{
-				Description: "Whether to send notifications about the event changes.",
-				Optional:    true,
-				Computed:    true,
-				Default:     booldefault.StaticBool(true),
+				Description: "Whether to send notifications about the event changes. Deprecated: use " +
+					"`send_updates`; `true` is equivalent to `\"all\"` and `false` to `\"none\"`.",
+				DeprecationMessage: "Use send_updates instead. send_notifications = true is equivalent to " +
+					"send_updates = \"all\", and false to send_updates = \"none\".",
+				Optional: true,
+				Computed: true,
+				Default:  booldefault.StaticBool(true),
+			},
+			"send_updates": schema.StringAttribute{
+				Description: "Who to send notifications about event changes to: `all` guests, " +
+					"`externalOnly` (guests outside the organizer's organization) or `none`. Defaults " +
+					"to the deprecated `send_notifications` when unset.",
+				Optional: true,
+				Validators: []validator.String{
+					stringvalidator.OneOf("all", "externalOnly", "none"),
+					stringvalidator.ConflictsWith(fwpath.MatchRoot("send_notifications")),
+				},
 			},
 			"visibility": schema.StringAttribute{
 				Description: "Visibility of the event.",
@@ -156,36 +381,183 @@ func (r *eventResource) Schema(ctx context.Context, req resource.SchemaRequest,
 					stringvalidator.OneOf("public", "private", ""),
 				},
 			},
-			"recurrence": schema.ListAttribute{
-				Description: "List of RRULE, EXRULE, RDATE and EXDATE lines for a recurring event.",
-				ElementType: types.StringType,
+			"recurrence": recurrenceAttribute(),
+			"upcoming_occurrences_count": schema.Int64Attribute{
+				Description: "How many occurrences to list in `upcoming_occurrences`.",
 				Optional:    true,
+				Computed:    true,
+				Default:     int64default.StaticInt64(defaultUpcomingOccurrences),
+				Validators: []validator.Int64{
+					int64validator.Between(0, 100),
+				},
+			},
+			"next_occurrence": schema.StringAttribute{
+				Description: "When the event next happens, as an RFC3339 timestamp in its `timezone`. " +
+					"Null once the event is over. Refreshed on every read.",
+				Computed: true,
 			},
-			"conference": schema.MapAttribute{
-				Description: "Conference data for the event.",
+			"upcoming_occurrences": schema.ListAttribute{
+				Description: "The next `upcoming_occurrences_count` occurrences of the event, as RFC3339 " +
+					"timestamps in its `timezone`. Refreshed on every read.",
 				ElementType: types.StringType,
-				Optional:    true,
+				Computed:    true,
+			},
+			"last_occurrence": schema.StringAttribute{
+				Description: "When the event happens for the last time, as an RFC3339 timestamp in its " +
+					"`timezone`. Null if it repeats forever.",
+				Computed: true,
+			},
+			"ends_on": schema.StringAttribute{
+				Description: "A date, as `YYYY-MM-DD`, from which the recurring event no longer happens. " +
+					"Each rule is capped with an UNTIL just before the first occurrence on or after it, " +
+					"in the event's `timezone`, keeping the occurrences before it - and their history - " +
+					"in place. `last_occurrence` previews the result at plan time.",
+				Optional: true,
+				Validators: []validator.String{
+					dateValidator{},
+					stringvalidator.AtLeastOneOf(
+						fwpath.MatchRoot("recurrence"),
+						fwpath.MatchRoot("schedule"),
+					),
+				},
+			},
+			"skip_holidays_calendar": schema.StringAttribute{
+				Description: "The id of a holiday calendar, e.g. " +
+					"`en.usa#holiday@group.v.calendar.google.com`. Occurrences of the recurring event " +
+					"falling on its holidays are skipped. The calendar is searched on every plan.",
+				Optional: true,
+			},
+			"skip_holidays_horizon_days": schema.Int64Attribute{
+				Description: "How many days ahead to search `skip_holidays_calendar` for holidays. " +
+					"Defaults to 365.",
+				Optional: true,
+				Computed: true,
+				Default:  int64default.StaticInt64(defaultHolidayHorizonDays),
+				Validators: []validator.Int64{
+					int64validator.AtLeast(1),
+				},
+			},
+			"skipped_holidays": schema.ListAttribute{
+				Description: "The holidays from `skip_holidays_calendar`, as `YYYY-MM-DD` dates, on which " +
+					"an occurrence is skipped. Past holidays stay in the list, so their occurrences stay " +
+					"skipped once they fall out of the horizon.",
+				ElementType: types.StringType,
+				Computed:    true,
+			},
+			"skip_when_attendee_out_of_office": schema.BoolAttribute{
+				Description: "Skip occurrences of the recurring event that the organizer or any required " +
+					"attendee who hasn't declined has an out-of-office event during. Calendars whose " +
+					"events the provider can't see fall back to free/busy, where being busy for a day or " +
+					"more counts as out of office; the rest produce a warning. They're checked on every plan.",
+				Optional: true,
+			},
+			"out_of_office_lookahead": schema.Int64Attribute{
+				Description: "How many upcoming occurrences `skip_when_attendee_out_of_office` checks. " +
+					"Defaults to 10.",
+				Optional: true,
+				Computed: true,
+				Default:  int64default.StaticInt64(defaultOutOfOfficeLookahead),
+				Validators: []validator.Int64{
+					int64validator.Between(1, 100),
+				},
+			},
+			"skipped_out_of_office": schema.ListAttribute{
+				Description: "The occurrences skipped because someone is out of office, as RFC3339 " +
+					"timestamps in the event's `timezone`. Past occurrences stay in the list, so they " +
+					"stay skipped once they're no longer checked.",
+				ElementType: types.StringType,
+				Computed:    true,
+			},
+			"google_meet_id": schema.StringAttribute{
+				Description: "The id of the event's Google Meet, e.g. `aaa-bbbb-ccc`.",
+				Computed:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
 			},
-			"html_link": schema.StringAttribute{
-				Description: "An absolute link to the event in the Google Calendar Web UI.",
+			"google_meet_uri": schema.StringAttribute{
+				Description: "The URI of the event's Google Meet.",
 				Computed:    true,
 				PlanModifiers: []planmodifier.String{
 					stringplanmodifier.UseStateForUnknown(),
 				},
 			},
-			"deletion_policy": schema.StringAttribute{
-				Description: "Behavior when this resource is destroyed (e.g. via `-replace`). " +
-					"`DELETE` (default) removes the entire recurring series, past and future. " +
-					"`TRUNCATE` caps the series with an UNTIL just before its next occurrence - " +
-					"equivalent to the calendar UI's \"this and following events\" delete - so past " +
-					"instances remain on the calendar.",
+			"max_attendees": schema.Int64Attribute{
+				Description: "The maximum number of attendees to request from the API. Events with more " +
+					"attendees than this come back truncated, in which case Read leaves the attendees in " +
+					"state alone and Update refuses to write them, rather than dropping guests.",
 				Optional: true,
 				Computed: true,
-				Default:  stringdefault.StaticString("DELETE"),
+				Default:  int64default.StaticInt64(defaultMaxAttendees),
+				Validators: []validator.Int64{
+					int64validator.AtLeast(1),
+				},
+			},
+			"room_response_timeout": schema.StringAttribute{
+				Description: "How long to wait after creating or updating the event for each `room` to " +
+					"accept or decline it, as a duration such as `90s`. A room that hasn't responded " +
+					"by then produces a warning; one that declines fails the apply.",
+				Optional: true,
+				Computed: true,
+				Default:  stringdefault.StaticString("2m"),
 				Validators: []validator.String{
-					stringvalidator.OneOf("DELETE", "TRUNCATE"),
+					durationValidator{},
+				},
+			},
+			"html_link": htmlLinkAttribute(),
+			"ical_uid": schema.StringAttribute{
+				Description: "The event's iCalendar UID (RFC 5545), shared by all copies of the event " +
+					"across calendars.",
+				Computed: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"etag": schema.StringAttribute{
+				Description: "ETag of the event, which changes whenever it does.",
+				Computed:    true,
+			},
+			"created": schema.StringAttribute{
+				Description: "When the event was created, as an RFC3339 timestamp.",
+				Computed:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"updated": schema.StringAttribute{
+				Description: "When the event was last modified, by anyone, as an RFC3339 timestamp.",
+				Computed:    true,
+			},
+			"organizer_email": schema.StringAttribute{
+				Description: "The email address of the event's organizer.",
+				Computed:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"creator_email": schema.StringAttribute{
+				Description: "The email address of whoever created the event.",
+				Computed:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"status": schema.StringAttribute{
+				Description: "Status of the event: `confirmed`, `tentative` or `cancelled`.",
+				Computed:    true,
+			},
+			"hangout_link": schema.StringAttribute{
+				Description: "The link to the event's Google Meet, as listed on the event itself.",
+				Computed:    true,
+			},
+			"recurring_event_id": schema.StringAttribute{
+				Description: "For an instance of a recurring event, the id of the series it belongs to.",
+				Computed:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
 				},
 			},
+			"deletion_policy": deletionPolicyAttribute(),
 			"auto_reconcile": schema.BoolAttribute{
 				Description: "When true, Read repoints this resource at the live continuation if the " +
 					"calendar forks its recurring series - e.g. a \"this and following events\" edit made " +
@@ -204,8 +576,10 @@ func (r *eventResource) Schema(ctx context.Context, req resource.SchemaRequest,
 				NestedObject: schema.NestedBlockObject{
 					Attributes: map[string]schema.Attribute{
 						"email": schema.StringAttribute{
-							Description: "The email address of the attendee.",
-							Required:    true,
+							Description: "The email address of the attendee. Compared case-insensitively, and " +
+								"across aliases when the provider's `resolve_attendee_aliases` is set.",
+							CustomType: emailType{},
+							Required:   true,
 						},
 						"optional": schema.BoolAttribute{
 							Description: "Whether this is an optional attendee.",
@@ -213,6 +587,58 @@ func (r *eventResource) Schema(ctx context.Context, req resource.SchemaRequest,
 							Computed:    true,
 							Default:     booldefault.StaticBool(false),
 						},
+						"display_name": schema.StringAttribute{
+							Description: "The attendee's name, if available.",
+							Optional:    true,
+							Computed:    true,
+						},
+						"comment": schema.StringAttribute{
+							Description: "The attendee's response comment.",
+							Optional:    true,
+							Computed:    true,
+						},
+						"additional_guests": schema.Int64Attribute{
+							Description: "Number of additional guests the attendee is bringing.",
+							Optional:    true,
+							Computed:    true,
+							Default:     int64default.StaticInt64(0),
+							Validators: []validator.Int64{
+								int64validator.AtLeast(0),
+							},
+						},
+						"response_status": schema.StringAttribute{
+							Description: "The attendee's response status: `needsAction`, `declined`, `tentative` or `accepted`.",
+							Computed:    true,
+						},
+						"organizer": schema.BoolAttribute{
+							Description: "Whether the attendee is the organizer of the event.",
+							Computed:    true,
+						},
+						"self": schema.BoolAttribute{
+							Description: "Whether this entry represents the calendar on which this copy of the event appears.",
+							Computed:    true,
+						},
+						"resource": schema.BoolAttribute{
+							Description: "Whether the attendee is a resource, such as a meeting room.",
+							Computed:    true,
+						},
+					},
+				},
+			},
+			"room": schema.SetNestedBlock{
+				Description: "Meeting rooms to book for the event. Rooms are invited as resource attendees; " +
+					"the apply waits for them to respond (see `room_response_timeout`).",
+				NestedObject: schema.NestedBlockObject{
+					Attributes: map[string]schema.Attribute{
+						"email": schema.StringAttribute{
+							Description: "The resource calendar email address of the room.",
+							CustomType:  emailType{},
+							Required:    true,
+						},
+						"response_status": schema.StringAttribute{
+							Description: "The room's response status: `needsAction`, `declined`, `tentative` or `accepted`.",
+							Computed:    true,
+						},
 					},
 				},
 			},
@@ -235,64 +661,506 @@ func (r *eventResource) Schema(ctx context.Context, req resource.SchemaRequest,
 					},
 				},
 			},
+			"schedule": schema.SingleNestedBlock{
+				Description: "A recurrence schedule, compiled to an RRULE - an alternative to writing " +
+					"`recurrence` lines by hand. Can't be used together with `recurrence`.",
+				Attributes: map[string]schema.Attribute{
+					"frequency": schema.StringAttribute{
+						Description: "How often the event repeats: `daily`, `weekly`, `monthly` or `yearly`.",
+						Required:    true,
+						Validators: []validator.String{
+							stringvalidator.OneOf("daily", "weekly", "monthly", "yearly"),
+						},
+					},
+					"interval": schema.Int64Attribute{
+						Description: "Repeat every this many days, weeks, months or years. Defaults to 1.",
+						Optional:    true,
+						Validators: []validator.Int64{
+							int64validator.AtLeast(1),
+						},
+					},
+					"by_day": schema.ListAttribute{
+						Description: "Days of the week the event happens on, e.g. `[\"MO\", \"WE\"]`. In a " +
+							"monthly or yearly schedule a day can be numbered: `1MO` is the first Monday, " +
+							"`-1FR` the last Friday.",
+						ElementType: types.StringType,
+						Optional:    true,
+						Validators: []validator.List{
+							listvalidator.ValueStringsAre(stringvalidator.RegexMatches(
+								regexp.MustCompile(`(?i)^[+-]?([1-9]|[1-4][0-9]|5[0-3])?(`+strings.Join(weekdays, "|")+`)$`),
+								"must be a two-letter weekday, optionally numbered, such as MO or -1FR",
+							)),
+						},
+					},
+					"by_month_day": schema.ListAttribute{
+						Description: "Days of the month the event happens on; negative days count back from " +
+							"the end of the month.",
+						ElementType: types.Int64Type,
+						Optional:    true,
+						Validators: []validator.List{
+							listvalidator.ValueInt64sAre(
+								int64validator.Between(-31, 31),
+								int64validator.NoneOf(0),
+							),
+						},
+					},
+					"by_set_pos": schema.ListAttribute{
+						Description: "Which of the occurrences within each period to keep, e.g. `[-1]` with " +
+							"`by_day = [\"MO\", \"TU\", \"WE\", \"TH\", \"FR\"]` for the last weekday of the month.",
+						ElementType: types.Int64Type,
+						Optional:    true,
+						Validators: []validator.List{
+							listvalidator.ValueInt64sAre(
+								int64validator.Between(-366, 366),
+								int64validator.NoneOf(0),
+							),
+						},
+					},
+					"count": schema.Int64Attribute{
+						Description: "End the series after this many occurrences.",
+						Optional:    true,
+						Validators: []validator.Int64{
+							int64validator.AtLeast(1),
+							int64validator.ConflictsWith(fwpath.MatchRelative().AtParent().AtName("until")),
+						},
+					},
+					"until": schema.StringAttribute{
+						Description: "End the series on this date (`YYYY-MM-DD`, the whole day in the event's " +
+							"`timezone`) or RFC3339 date-time.",
+						Optional: true,
+					},
+					"week_start": schema.StringAttribute{
+						Description: "The day weeks start on, which matters for `weekly` schedules with an " +
+							"`interval` above 1. Defaults to `MO`.",
+						Optional: true,
+						Validators: []validator.String{
+							stringvalidator.OneOf(weekdays...),
+						},
+					},
+				},
+			},
+			"skip": schema.SetNestedBlock{
+				Description: "Dates, or ranges of dates, on which the recurring event doesn't happen - " +
+					"e.g. holidays or a company shutdown. Each occurrence falling in one is excluded " +
+					"with an EXDATE. Dates are whole days in the event's `timezone`.",
+				NestedObject: schema.NestedBlockObject{
+					Attributes: map[string]schema.Attribute{
+						"date": schema.StringAttribute{
+							Description: "A single day to skip, as `YYYY-MM-DD`.",
+							Optional:    true,
+							Validators: []validator.String{
+								dateValidator{},
+								stringvalidator.ExactlyOneOf(fwpath.MatchRelative().AtParent().AtName("from")),
+							},
+						},
+						"from": schema.StringAttribute{
+							Description: "The first day of a range to skip, as `YYYY-MM-DD`.",
+							Optional:    true,
+							Validators: []validator.String{
+								dateValidator{},
+								stringvalidator.AlsoRequires(fwpath.MatchRelative().AtParent().AtName("to")),
+							},
+						},
+						"to": schema.StringAttribute{
+							Description: "The last day of a range to skip, as `YYYY-MM-DD`.",
+							Optional:    true,
+							Validators: []validator.String{
+								dateValidator{},
+								stringvalidator.AlsoRequires(fwpath.MatchRelative().AtParent().AtName("from")),
+							},
+						},
+					},
+				},
+			},
+			"conference": schema.SingleNestedBlock{
+				Description: "Conference data for the event. Set `conference_id` to attach an existing " +
+					"conference, or `create_google_meet` to have a new Google Meet created. Third-party " +
+					"conferences, such as Zoom meetings added by a Workspace add-on, use solution type " +
+					"`addOn` and list their entry points explicitly.",
+				Attributes: map[string]schema.Attribute{
+					"solution_type": schema.StringAttribute{
+						Description: "The conference solution: `hangoutsMeet` (the default), `addOn`, " +
+							"`eventHangout` or `eventNamedHangout`.",
+						Optional: true,
+						Computed: true,
+						Validators: []validator.String{
+							stringvalidator.OneOf("hangoutsMeet", "addOn", "eventHangout", "eventNamedHangout"),
+						},
+						PlanModifiers: []planmodifier.String{
+							stringplanmodifier.UseStateForUnknown(),
+						},
+					},
+					"solution_name": schema.StringAttribute{
+						Description: "The user-visible name of the conference solution, e.g. `Zoom Meeting`.",
+						Optional:    true,
+						Computed:    true,
+						PlanModifiers: []planmodifier.String{
+							stringplanmodifier.UseStateForUnknown(),
+						},
+					},
+					"conference_id": schema.StringAttribute{
+						Description: "The ID of the conference, e.g. `aaa-bbbb-ccc` for Google Meet. A Google " +
+							"Meet given only by its ID gets its video entry point filled in.",
+						Optional: true,
+						Computed: true,
+						PlanModifiers: []planmodifier.String{
+							stringplanmodifier.UseStateForUnknown(),
+						},
+					},
+					"create_google_meet": schema.BoolAttribute{
+						Description: "Whether to have a new Google Meet created for the event. The meeting is " +
+							"created once and kept across updates.",
+						Optional: true,
+						Validators: []validator.Bool{
+							boolvalidator.ConflictsWith(
+								fwpath.MatchRelative().AtParent().AtName("conference_id"),
+								fwpath.MatchRelative().AtParent().AtName("entry_points"),
+							),
+						},
+					},
+					"notes": schema.StringAttribute{
+						Description: "Additional notes to display to the user, such as instructions from the " +
+							"domain administrator.",
+						Optional: true,
+					},
+					"entry_points": schema.ListNestedAttribute{
+						Description: "The ways of joining the conference: at most one each of `video`, `sip` " +
+							"and `more`, and any number of `phone` entry points.",
+						Optional: true,
+						Computed: true,
+						NestedObject: schema.NestedAttributeObject{
+							Attributes: map[string]schema.Attribute{
+								"entry_point_type": schema.StringAttribute{
+									Description: "The type of entry point: `video`, `phone`, `sip` or `more`.",
+									Required:    true,
+									Validators: []validator.String{
+										stringvalidator.OneOf("video", "phone", "sip", "more"),
+									},
+								},
+								"uri": schema.StringAttribute{
+									Description: "The URI of the entry point, e.g. `https://...`, `tel:+...` or `sip:...`.",
+									Required:    true,
+								},
+								"label": schema.StringAttribute{
+									Description: "The label shown for the URI, e.g. a formatted phone number.",
+									Optional:    true,
+								},
+								"pin": schema.StringAttribute{
+									Description: "The PIN to access the conference.",
+									Optional:    true,
+								},
+								"passcode": schema.StringAttribute{
+									Description: "The passcode to access the conference.",
+									Optional:    true,
+									Sensitive:   true,
+								},
+								"access_code": schema.StringAttribute{
+									Description: "The access code to access the conference.",
+									Optional:    true,
+								},
+								"meeting_code": schema.StringAttribute{
+									Description: "The meeting code to access the conference.",
+									Optional:    true,
+								},
+								"password": schema.StringAttribute{
+									Description: "The password to access the conference.",
+									Optional:    true,
+									Sensitive:   true,
+								},
+								"region_code": schema.StringAttribute{
+									Description: "The CLDR/ISO 3166 region code of a `phone` entry point's country, e.g. `US`.",
+									Optional:    true,
+								},
+							},
+						},
+					},
+				},
+			},
+			"source": schema.SingleNestedBlock{
+				Description: "Source from which the event was created, shown as a link on the event - " +
+					"e.g. the file in the repository that manages it.",
+				Attributes: map[string]schema.Attribute{
+					"title": schema.StringAttribute{
+						Description: "Title of the source, such as a file path.",
+						Optional:    true,
+					},
+					"url": schema.StringAttribute{
+						Description: "URL of the source. Must use the HTTP or HTTPS scheme.",
+						Required:    true,
+						Validators: []validator.String{
+							stringvalidator.RegexMatches(regexp.MustCompile(`^https?://`), "must be an HTTP or HTTPS URL"),
+						},
+					},
+				},
+			},
+			"extended_properties": schema.SingleNestedBlock{
+				Description: "Extended properties of the event. Keys are merged with any already on the " +
+					"event, so properties set by other applications are left alone; only the keys " +
+					"declared here are tracked.",
+				Attributes: map[string]schema.Attribute{
+					"private": schema.MapAttribute{
+						Description: "Properties private to the copy of the event on this calendar.",
+						ElementType: types.StringType,
+						Optional:    true,
+					},
+					"shared": schema.MapAttribute{
+						Description: "Properties shared between copies of the event on other attendees' calendars.",
+						ElementType: types.StringType,
+						Optional:    true,
+					},
+				},
+			},
 		},
 	}
 }
 
-// Configure adds the provider configured client to the resource.
-func (r *eventResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
-	if req.ProviderData == nil {
+// ConfigValidators returns validators that check the resource configuration
+// as a whole.
+func (r *eventResource) ConfigValidators(ctx context.Context) []resource.ConfigValidator {
+	return []resource.ConfigValidator{
+		resourcevalidator.Conflicting(
+			fwpath.MatchRoot("recurrence"),
+			fwpath.MatchRoot("schedule"),
+		),
+	}
+}
+
+// ValidateConfig checks the time zone, start and end, and Google Meet ID at
+// plan time. The API would reject all of them too, but only mid-apply - after
+// earlier resources in the same run have already notified their attendees.
+func (r *eventResource) ValidateConfig(ctx context.Context, req resource.ValidateConfigRequest, resp *resource.ValidateConfigResponse) {
+	var config eventResourceModel
+
+	// Read Terraform configuration data into the model
+	resp.Diagnostics.Append(req.Config.Get(ctx, &config)...)
+	if resp.Diagnostics.HasError() {
 		return
 	}
 
-	config, ok := req.ProviderData.(*Config)
-	if !ok {
-		resp.Diagnostics.AddError(
-			"Unexpected Resource Configure Type",
-			fmt.Sprintf("Expected *Config, got: %T", req.ProviderData),
+	// Check the time zone, start and end
+	start, loc, startOK, diags := validateEventTime(config.Start, config.End, config.Timezone)
+	resp.Diagnostics.Append(diags...)
+
+	// Check that ends_on leaves the series at least its first day, or its
+	// UNTIL would fall before DTSTART
+	if startOK && !config.EndsOn.IsNull() && !config.EndsOn.IsUnknown() {
+		firstDay := start.In(loc).Format(time.DateOnly)
+		if endsOn, err := time.Parse(time.DateOnly, config.EndsOn.ValueString()); err == nil &&
+			endsOn.Format(time.DateOnly) <= firstDay {
+			resp.Diagnostics.AddAttributeError(
+				fwpath.Root("ends_on"),
+				"Invalid Series End",
+				fmt.Sprintf("ends_on (%s) must be after the day the series starts (%s), or the series "+
+					"would end before its first occurrence.", config.EndsOn.ValueString(), firstDay),
+			)
+		}
+	}
+
+	// Check that skip blocks have a series to skip occurrences of
+	if len(config.Skips.Elements()) > 0 && config.Recurrence.IsNull() && config.Schedule.IsNull() {
+		resp.Diagnostics.AddAttributeError(
+			fwpath.Root("skip"),
+			"Skip Without Recurrence",
+			"skip only applies to recurring events; set recurrence or schedule, or remove the skip blocks.",
 		)
-		return
 	}
 
-	r.config = config
+	// Check the shape of a Google Meet ID
+	if !config.Conference.IsNull() && !config.Conference.IsUnknown() {
+		var conference conferenceModel
+		resp.Diagnostics.Append(config.Conference.As(ctx, &conference, basetypes.ObjectAsOptions{})...)
+
+		googleMeet := conference.SolutionType.IsNull() || conference.SolutionType.ValueString() == "hangoutsMeet"
+		if googleMeet && !conference.ConferenceID.IsNull() && !conference.ConferenceID.IsUnknown() &&
+			!googleMeetIDPattern.MatchString(conference.ConferenceID.ValueString()) {
+			resp.Diagnostics.AddAttributeError(
+				fwpath.Root("conference").AtName("conference_id"),
+				"Invalid Google Meet ID",
+				fmt.Sprintf("%q is not a Google Meet ID, which looks like \"aaa-bbbb-ccc\".", conference.ConferenceID.ValueString()),
+			)
+		}
+	}
 }
 
-// Create creates the resource and sets the initial Terraform state.
-func (r *eventResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
-	var plan eventResourceModel
+// ModifyPlan searches skip_holidays_calendar for the holidays the event's
+// occurrences fall on, and attendees' calendars for the occurrences they're
+// out of office for, so a newly published holiday or newly booked vacation
+// shows up in the plan. It also stops the attributes that follow the
+// conference from being kept from state when the conference changes, and
+// start and end when the time zone does (see planTimeZoneChange).
+func (r *eventResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	// Nothing to do when destroying
+	if req.Plan.Raw.IsNull() {
+		return
+	}
 
-	// Read Terraform plan data into the model
-	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	planTimeZoneChange(ctx, req, resp)
+
+	var plan eventResourceModel
+	resp.Diagnostics.Append(resp.Plan.Get(ctx, &plan)...)
 	if resp.Diagnostics.HasError() {
 		return
 	}
 
-	// Build the event
-	event, diags := r.buildEvent(ctx, &plan, &calendar.Event{})
+	priorHolidays := types.ListNull(types.StringType)
+	priorOutOfOffice := types.ListNull(types.StringType)
+	if !req.State.Raw.IsNull() {
+		resp.Diagnostics.Append(req.State.GetAttribute(ctx, fwpath.Root("skipped_holidays"), &priorHolidays)...)
+		resp.Diagnostics.Append(req.State.GetAttribute(ctx, fwpath.Root("skipped_out_of_office"), &priorOutOfOffice)...)
+	}
+
+	holidays, diags := r.skippedHolidays(ctx, &plan, priorHolidays)
+	resp.Diagnostics.Append(diags...)
+	outOfOffice, diags := r.skippedOutOfOffice(ctx, &plan, priorOutOfOffice)
 	resp.Diagnostics.Append(diags...)
 	if resp.Diagnostics.HasError() {
 		return
 	}
 
-	// Create the event via API
-	sendNotifications := plan.SendNotifications.ValueBool()
-	eventAPI, err := r.config.calendar.Events.
-		Insert("primary", event).
-		SupportsAttachments(true).
-		ConferenceDataVersion(1).
-		SendNotifications(sendNotifications).
-		MaxAttendees(25).
-		Do()
-	if err != nil {
-		resp.Diagnostics.AddError(
-			"Error creating event",
-			fmt.Sprintf("Could not create event: %s", err),
-		)
+	resp.Diagnostics.Append(resp.Plan.SetAttribute(ctx, fwpath.Root("skipped_holidays"), holidays)...)
+	resp.Diagnostics.Append(resp.Plan.SetAttribute(ctx, fwpath.Root("skipped_out_of_office"), outOfOffice)...)
+
+	// A change of skipped occurrences alone still updates the event, so the
+	// attributes that change with every update can't be kept from state
+	if !req.State.Raw.IsNull() && (!holidays.Equal(priorHolidays) || !outOfOffice.Equal(priorOutOfOffice)) {
+		for _, name := range []string{"etag", "updated", "next_occurrence", "last_occurrence"} {
+			resp.Diagnostics.Append(resp.Plan.SetAttribute(ctx, fwpath.Root(name), types.StringUnknown())...)
+		}
+		resp.Diagnostics.Append(resp.Plan.SetAttribute(ctx, fwpath.Root("upcoming_occurrences"), types.ListUnknown(types.StringType))...)
+		plan.LastOccurrence = types.StringUnknown()
+	}
+
+	// The Meet and the conference's own computed attributes follow the
+	// conference, so they can't be kept from state once it changes
+	if !req.State.Raw.IsNull() {
+		var config, prior types.Object
+		resp.Diagnostics.Append(req.Config.GetAttribute(ctx, fwpath.Root("conference"), &config)...)
+		resp.Diagnostics.Append(req.State.GetAttribute(ctx, fwpath.Root("conference"), &prior)...)
+		if conferenceChanged(config, prior) {
+			for _, name := range []string{"google_meet_id", "google_meet_uri"} {
+				resp.Diagnostics.Append(resp.Plan.SetAttribute(ctx, fwpath.Root(name), types.StringUnknown())...)
+			}
+			if !plan.Conference.IsNull() && !config.IsUnknown() {
+				configured := config.Attributes()
+				for _, name := range []string{"solution_type", "solution_name", "conference_id"} {
+					if configured[name].IsNull() {
+						resp.Diagnostics.Append(resp.Plan.SetAttribute(ctx, fwpath.Root("conference").AtName(name), types.StringUnknown())...)
+					}
+				}
+			}
+		}
+	}
+
+	// Preview where ends_on leaves the series
+	if !plan.EndsOn.IsNull() && plan.LastOccurrence.IsUnknown() {
+		plan.SkippedHolidays, plan.SkippedOutOfOffice = holidays, outOfOffice
+		last, diags := previewLastOccurrence(ctx, &plan)
+		resp.Diagnostics.Append(diags...)
+		resp.Diagnostics.Append(resp.Plan.SetAttribute(ctx, fwpath.Root("last_occurrence"), last)...)
+	}
+}
+
+// conferenceChanged reports whether the configured conference block differs
+// from the one in state, comparing only the attributes the configuration sets.
+func conferenceChanged(config, state types.Object) bool {
+	if config.IsUnknown() || config.IsNull() != state.IsNull() {
+		return true
+	}
+	if config.IsNull() {
+		return false
+	}
+
+	prior := state.Attributes()
+	for name, value := range config.Attributes() {
+		if !value.IsNull() && !value.Equal(prior[name]) {
+			return true
+		}
+	}
+	return false
+}
+
+// previewLastOccurrence returns the last occurrence of the event the model
+// builds, as readOccurrences will read it back, or unknown if that can't be
+// worked out without calling the API.
+func previewLastOccurrence(ctx context.Context, model *eventResourceModel) (types.String, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	if model.EndsOn.IsUnknown() || model.Start.IsUnknown() || model.End.IsUnknown() || model.Timezone.IsUnknown() ||
+		model.Recurrence.IsUnknown() || model.Schedule.IsUnknown() || model.Skips.IsUnknown() ||
+		model.SkippedHolidays.IsUnknown() || model.SkippedOutOfOffice.IsUnknown() {
+		return types.StringUnknown(), diags
+	}
+
+	event := &calendar.Event{}
+	diags = append(diags, buildEventTime(ctx, model.Start, model.End, model.Timezone, model.Recurrence, event)...)
+	if lines, d := recurrenceLines(ctx, model); !d.HasError() {
+		event.Recurrence = lines
+	}
+	diags = append(diags, applySkips(ctx, model, event)...)
+	diags = append(diags, buildEndsOn(ctx, model, event)...)
+	if diags.HasError() {
+		return types.StringUnknown(), diags
+	}
+
+	set, err := recurrenceSet(event.Recurrence, event.Start)
+	if err != nil {
+		return types.StringUnknown(), diags
+	}
+	last := lastOccurrence(set)
+	if last == nil {
+		return types.StringNull(), diags
+	}
+
+	return types.StringValue(inTimeZone(last.Format(time.RFC3339), model.Timezone.ValueString())), diags
+}
+
+// Configure adds the provider configured client to the resource.
+func (r *eventResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	r.config = configureResource(req, resp)
+}
+
+// Create creates the resource and sets the initial Terraform state.
+func (r *eventResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var plan eventResourceModel
+
+	// Read Terraform plan data into the model
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Build the event
+	event, diags := r.buildEvent(ctx, &plan, &calendar.Event{})
+	resp.Diagnostics.Append(diags...)
+	resp.Diagnostics.Append(setMeetRequestID(ctx, resp.Private, event)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Create the event via API
+	eventAPI, err := r.config.calendar.Events.
+		Insert("primary", event).
+		SupportsAttachments(true).
+		ConferenceDataVersion(1).
+		SendUpdates(sendUpdates(&plan)).
+		MaxAttendees(maxAttendees(&plan)).
+		Do()
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error creating event",
+			fmt.Sprintf("Could not create event: %s", err),
+		)
 		return
 	}
 
 	// Set the ID
 	plan.ID = types.StringValue(eventAPI.Id)
+	resp.Diagnostics.Append(clearMeetRequestID(ctx, resp.Private, eventAPI)...)
+
+	// Wait for any rooms to respond. Errors here still fall through to
+	// setting state, so the event just created stays tracked.
+	eventAPI, diags = r.waitForRooms(ctx, &plan, types.SetNull(types.ObjectType{AttrTypes: roomAttrTypes}), eventAPI)
+	resp.Diagnostics.Append(diags...)
 
 	// Read the event to populate computed fields
 	r.readEvent(ctx, &plan, eventAPI)
@@ -314,6 +1182,7 @@ func (r *eventResource) Read(ctx context.Context, req resource.ReadRequest, resp
 	// Get the event from the API
 	event, err := r.config.calendar.Events.
 		Get("primary", state.ID.ValueString()).
+		MaxAttendees(maxAttendees(&state)).
 		Do()
 	if err != nil {
 		resp.Diagnostics.AddError(
@@ -364,6 +1233,15 @@ func (r *eventResource) Read(ctx context.Context, req resource.ReadRequest, resp
 		}
 	}
 
+	if event.AttendeesOmitted {
+		resp.Diagnostics.AddWarning(
+			"Attendee list truncated",
+			fmt.Sprintf("%q (id %s) has more attendees than max_attendees (%d), so the API omitted "+
+				"some of them. Attendees were left as they were in state; raise max_attendees to "+
+				"refresh them.", event.Summary, event.Id, maxAttendees(&state)),
+		)
+	}
+
 	// Update the state with the API data
 	r.readEvent(ctx, &state, event)
 
@@ -373,10 +1251,11 @@ func (r *eventResource) Read(ctx context.Context, req resource.ReadRequest, resp
 
 // Update updates the resource and sets the updated Terraform state on success.
 func (r *eventResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
-	var plan eventResourceModel
+	var plan, state eventResourceModel
 
-	// Read Terraform plan data into the model
+	// Read Terraform plan and prior state data into the models
 	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
 	if resp.Diagnostics.HasError() {
 		return
 	}
@@ -384,6 +1263,7 @@ func (r *eventResource) Update(ctx context.Context, req resource.UpdateRequest,
 	// Get the current event from the API
 	event, err := r.config.calendar.Events.
 		Get("primary", plan.ID.ValueString()).
+		MaxAttendees(maxAttendees(&plan)).
 		Do()
 	if err != nil {
 		resp.Diagnostics.AddError(
@@ -393,21 +1273,42 @@ func (r *eventResource) Update(ctx context.Context, req resource.UpdateRequest,
 		return
 	}
 
+	// Events.Update replaces the whole event, so writing back a truncated
+	// attendee list - or leaving it out - would drop everyone the API left
+	// out of it.
+	if event.AttendeesOmitted {
+		resp.Diagnostics.AddError(
+			"Refusing to update truncated attendee list",
+			fmt.Sprintf("%q (id %s) has more attendees than max_attendees (%d), so the API omitted "+
+				"some of them. Updating it now would remove those guests from the event; raise "+
+				"max_attendees and apply again.", event.Summary, event.Id, maxAttendees(&plan)),
+		)
+		return
+	}
+
+	// Drop extended properties and rooms removed from the configuration
+	// since the last apply; buildEvent only ever merges them in.
+	resp.Diagnostics.Append(pruneExtendedProperties(ctx, &state, &plan, event)...)
+	resp.Diagnostics.Append(pruneRooms(ctx, &state, &plan, event)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
 	// Build the updated event
 	event, diags := r.buildEvent(ctx, &plan, event)
 	resp.Diagnostics.Append(diags...)
+	resp.Diagnostics.Append(setMeetRequestID(ctx, resp.Private, event)...)
 	if resp.Diagnostics.HasError() {
 		return
 	}
 
 	// Update the event via API
-	sendNotifications := plan.SendNotifications.ValueBool()
 	eventAPI, err := r.config.calendar.Events.
 		Update("primary", plan.ID.ValueString(), event).
 		SupportsAttachments(true).
 		ConferenceDataVersion(1).
-		SendNotifications(sendNotifications).
-		MaxAttendees(25).
+		SendUpdates(sendUpdates(&plan)).
+		MaxAttendees(maxAttendees(&plan)).
 		Do()
 	if err != nil {
 		resp.Diagnostics.AddError(
@@ -416,6 +1317,18 @@ func (r *eventResource) Update(ctx context.Context, req resource.UpdateRequest,
 		)
 		return
 	}
+	resp.Diagnostics.Append(clearMeetRequestID(ctx, resp.Private, eventAPI)...)
+
+	// Wait for any newly added rooms to respond. Errors here still fall
+	// through to setting state, so it reflects the update that was made.
+	eventAPI, diags = r.waitForRooms(ctx, &plan, state.Rooms, eventAPI)
+	resp.Diagnostics.Append(diags...)
+
+	// Prefer the prior state's view of attendees the API leaves out of its
+	// response over the defaults readEvent would fill them in with
+	if eventAPI.AttendeesOmitted {
+		resolveAttendees(ctx, &plan, append(eventAPI.Attendees, modelAttendees(ctx, &state)...))
+	}
 
 	// Update the state with the API data
 	r.readEvent(ctx, &plan, eventAPI)
@@ -424,13 +1337,8 @@ func (r *eventResource) Update(ctx context.Context, req resource.UpdateRequest,
 	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
 }
 
-// Delete deletes the resource and removes the Terraform state on success. If
-// deletion_policy is "TRUNCATE", the underlying series is capped just before
-// its next occurrence instead of being deleted outright - equivalent to the
-// calendar UI's "this and following events" delete - so past instances stay
-// on the calendar. Either way, Terraform drops the resource from state once
-// Delete returns without error; that part isn't conditional on what the API
-// call underneath actually did.
+// Delete deletes the resource and removes the Terraform state on success,
+// honoring deletion_policy (see deleteEvent).
 func (r *eventResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
 	var state eventResourceModel
 
@@ -440,77 +1348,49 @@ func (r *eventResource) Delete(ctx context.Context, req resource.DeleteRequest,
 		return
 	}
 
-	sendNotifications := state.SendNotifications.ValueBool()
-
-	if state.DeletionPolicy.ValueString() == "TRUNCATE" && !state.Recurrence.IsNull() {
-		truncated, err := r.truncateRecurrence(ctx, state.ID.ValueString(), sendNotifications)
-		if err != nil {
-			resp.Diagnostics.AddError(
-				"Error truncating event",
-				fmt.Sprintf("Could not truncate event %s: %s", state.ID.ValueString(), err),
-			)
-			return
-		}
-		if truncated {
-			return
-		}
-		// No future occurrence found - the series has already run its
-		// course, so there's nothing left to preserve. Fall through to a
-		// normal delete.
-	}
+	resp.Diagnostics.Append(deleteEvent(
+		ctx,
+		r.config.calendar,
+		state.ID.ValueString(),
+		state.DeletionPolicy.ValueString(),
+		!state.Recurrence.IsNull() || !state.Schedule.IsNull(),
+		sendUpdates(&state),
+		maxAttendees(&state),
+	)...)
+}
 
-	// Delete the event via API
-	err := r.config.calendar.Events.
-		Delete("primary", state.ID.ValueString()).
-		SendNotifications(sendNotifications).
-		Do()
-	if err != nil {
-		resp.Diagnostics.AddError(
-			"Error deleting event",
-			fmt.Sprintf("Could not delete event %s: %s", state.ID.ValueString(), err),
-		)
-		return
-	}
+// nextOccurrenceBoundary returns the UNTIL bound - one second before the next
+// occurrence at or after now, in the UTC form Google writes (RFC 5545) - or
+// nil if the series has no future occurrence.
+func nextOccurrenceBoundary(recurrence []string, start *calendar.EventDateTime) (*time.Time, error) {
+	return occurrenceBoundary(recurrence, start, time.Now())
 }
 
-// truncateRecurrence caps a recurring event's RRULEs at its next occurrence,
-// rather than deleting it, so past instances remain visible on the calendar.
-// It reports false (with no error) when the series has no future occurrence
-// left to cap.
-func (r *eventResource) truncateRecurrence(ctx context.Context, id string, sendNotifications bool) (bool, error) {
+// endsOnBoundary returns the UNTIL bound for ends_on, a date in the start's
+// time zone: one second before the first occurrence on or after it, or nil
+// if the series is over by then.
+func endsOnBoundary(recurrence []string, start *calendar.EventDateTime, endsOn string) (*time.Time, error) {
 
-	event, err := r.config.calendar.Events.Get("primary", id).Do()
-	if err != nil {
-		return false, fmt.Errorf("reading event: %w", err)
+	if start == nil {
+		return nil, fmt.Errorf("event has no start date-time")
 	}
-
-	boundary, err := nextOccurrenceBoundary(event.Recurrence, event.Start)
+	loc, err := time.LoadLocation(start.TimeZone)
 	if err != nil {
-		return false, fmt.Errorf("computing recurrence boundary: %w", err)
+		return nil, fmt.Errorf("loading time zone %q: %w", start.TimeZone, err)
 	}
-	if boundary == nil {
-		return false, nil
-	}
-
-	event.Recurrence = capRecurrenceUntil(event.Recurrence, *boundary)
-
-	_, err = r.config.calendar.Events.
-		Update("primary", id, event).
-		SendNotifications(sendNotifications).
-		Do()
+	day, err := time.ParseInLocation(time.DateOnly, endsOn, loc)
 	if err != nil {
-		return false, fmt.Errorf("updating recurrence: %w", err)
+		return nil, err
 	}
 
-	return true, nil
+	return occurrenceBoundary(recurrence, start, day)
 }
 
-// nextOccurrenceBoundary returns the UNTIL bound - one second before the next
-// occurrence at or after now, in the UTC form Google writes (RFC 5545) - or
-// nil if the series has no future occurrence.
-func nextOccurrenceBoundary(recurrence []string, start *calendar.EventDateTime) (*time.Time, error) {
+// occurrenceBoundary returns the UNTIL bound one second before the first
+// occurrence at or after at, or nil if there's no such occurrence.
+func occurrenceBoundary(recurrence []string, start *calendar.EventDateTime, at time.Time) (*time.Time, error) {
 
-	next, err := nextOccurrenceAt(recurrence, start, time.Now())
+	next, err := nextOccurrenceAt(recurrence, start, at)
 	if err != nil || next == nil {
 		return nil, err
 	}
@@ -523,27 +1403,111 @@ func nextOccurrenceBoundary(recurrence []string, start *calendar.EventDateTime)
 // after, or nil if the series has no such occurrence.
 func nextOccurrenceAt(recurrence []string, start *calendar.EventDateTime, after time.Time) (*time.Time, error) {
 
+	set, err := recurrenceSet(recurrence, start)
+	if err != nil {
+		return nil, err
+	}
+
+	return nextOccurrence(set, after), nil
+}
+
+// nextOccurrence returns the first occurrence of set at or after after, or
+// nil if there's no such occurrence.
+func nextOccurrence(set *rrule.Set, after time.Time) *time.Time {
+
+	next := set.After(after, true)
+	if next.IsZero() {
+		return nil
+	}
+
+	return &next
+}
+
+// recurrenceSet returns the set of occurrences recurrence generates from
+// start. An event without a recurrence is a set of one: its start.
+//
+// Rules are expanded in the start's IANA time zone rather than at its UTC
+// offset, as Google does, so a 14:30 series stays at 14:30 local time across
+// DST changes. EXDATE and RDATE values without a TZID or a trailing Z are
+// likewise local times in that zone.
+func recurrenceSet(recurrence []string, start *calendar.EventDateTime) (*rrule.Set, error) {
+
 	if start == nil || start.DateTime == "" {
 		return nil, fmt.Errorf("event has no start date-time")
 	}
 
-	dtStart, err := time.Parse(time.RFC3339, start.DateTime)
+	dtStart, hasOffset, err := parseDateTime(start.DateTime)
 	if err != nil {
 		return nil, fmt.Errorf("parsing start %q: %w", start.DateTime, err)
 	}
 
-	set, err := rrule.StrToRRuleSet(strings.Join(recurrence, "\n"))
+	switch {
+	case start.TimeZone != "":
+		loc, err := time.LoadLocation(start.TimeZone)
+		if err != nil {
+			return nil, fmt.Errorf("loading time zone %q: %w", start.TimeZone, err)
+		}
+		if hasOffset {
+			dtStart = dtStart.In(loc)
+		} else {
+			// As configured, before the API has added an offset
+			dtStart = time.Date(dtStart.Year(), dtStart.Month(), dtStart.Day(),
+				dtStart.Hour(), dtStart.Minute(), dtStart.Second(), 0, loc)
+		}
+	case !hasOffset:
+		return nil, fmt.Errorf("start %q has neither a UTC offset nor a time zone", start.DateTime)
+	}
+
+	if len(recurrence) == 0 {
+		set := &rrule.Set{}
+		set.DTStart(dtStart)
+		set.RDate(dtStart)
+		return set, nil
+	}
+
+	lines := make([]string, len(recurrence))
+	for i, line := range recurrence {
+		lines[i] = strings.TrimSpace(line)
+	}
+	set, err := rrule.StrSliceToRRuleSetInLoc(lines, dtStart.Location())
 	if err != nil {
 		return nil, fmt.Errorf("parsing recurrence %v: %w", recurrence, err)
 	}
 	set.DTStart(dtStart)
 
-	next := set.After(after, true)
-	if next.IsZero() {
-		return nil, nil
+	return set, nil
+}
+
+// upcomingOccurrences returns up to n occurrences of set at or after after.
+func upcomingOccurrences(set *rrule.Set, after time.Time, n int) []time.Time {
+
+	var upcoming []time.Time
+	for inc := true; len(upcoming) < n; inc = false {
+		next := set.After(after, inc)
+		if next.IsZero() {
+			break
+		}
+		upcoming = append(upcoming, next)
+		after = next
+	}
+
+	return upcoming
+}
+
+// lastOccurrence returns the final occurrence of set, or nil if its rule
+// repeats forever (or it has no occurrences at all).
+func lastOccurrence(set *rrule.Set) *time.Time {
+
+	if rule := set.GetRRule(); rule != nil && rule.OrigOptions.Count == 0 && rule.OrigOptions.Until.IsZero() {
+		return nil
+	}
+
+	all := set.All()
+	if len(all) == 0 {
+		return nil
 	}
 
-	return &next, nil
+	return &all[len(all)-1]
 }
 
 // untilFrom returns the UNTIL bound of a recurrence's RRULE, if it has one.
@@ -588,12 +1552,8 @@ func forkedSince(ctx context.Context, state *eventResourceModel, event *calendar
 		return time.Time{}, nil, false
 	}
 
-	if state.Recurrence.IsNull() {
-		return until, nil, true
-	}
-
-	var priorRecurrence []string
-	if diags := state.Recurrence.ElementsAs(ctx, &priorRecurrence, false); diags.HasError() {
+	priorRecurrence, diags := recurrenceLines(ctx, state)
+	if diags.HasError() || priorRecurrence == nil {
 		return until, nil, true
 	}
 
@@ -601,9 +1561,40 @@ func forkedSince(ctx context.Context, state *eventResourceModel, event *calendar
 		return time.Time{}, nil, false
 	}
 
+	// The cap ends_on puts on the series isn't a fork
+	if !state.EndsOn.IsNull() {
+		if boundary, err := endsOnBoundary(priorRecurrence, event.Start, state.EndsOn.ValueString()); err == nil &&
+			boundary != nil && boundary.Equal(until) {
+			return time.Time{}, nil, false
+		}
+	}
+
 	return until, priorRecurrence, true
 }
 
+// recurrenceLines returns the model's recurrence lines - compiled from its
+// schedule, if that's used instead - or nil if the event doesn't recur.
+func recurrenceLines(ctx context.Context, model *eventResourceModel) ([]string, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	if !model.Schedule.IsNull() && !model.Schedule.IsUnknown() {
+		var schedule scheduleModel
+		diags = append(diags, model.Schedule.As(ctx, &schedule, basetypes.ObjectAsOptions{})...)
+		if diags.HasError() {
+			return nil, diags
+		}
+		return compileSchedule(ctx, &schedule, model.Timezone.ValueString())
+	}
+
+	if model.Recurrence.IsNull() || model.Recurrence.IsUnknown() {
+		return nil, diags
+	}
+
+	var lines []string
+	diags = append(diags, model.Recurrence.ElementsAs(ctx, &lines, false)...)
+	return lines, diags
+}
+
 // findFork searches forward from a series' UNTIL cap for the live
 // continuation Google forked it onto - a same-summary event carrying a
 // recurring event id other than oldID - and returns that continuation's
@@ -672,124 +1663,493 @@ func (r *eventResource) findFork(ctx context.Context, summary string, recurrence
 		"matching %q", checked, summary), nil
 }
 
-// capRecurrenceUntil replaces (or adds) the UNTIL component of each RRULE
-// line with boundary, leaving EXRULE/RDATE/EXDATE lines untouched.
-func capRecurrenceUntil(recurrence []string, boundary time.Time) []string {
+// skippedHolidays returns the holidays on skip_holidays_calendar within the
+// horizon that the model's occurrences fall on, along with any in prior
+// that have already passed. It's unknown until everything it depends on is.
+func (r *eventResource) skippedHolidays(ctx context.Context, model *eventResourceModel, prior types.List) (types.List, diag.Diagnostics) {
+	var diags diag.Diagnostics
 
-	until := boundary.Format("20060102T150405Z")
-	capped := make([]string, len(recurrence))
+	if model.SkipHolidaysCalendar.IsNull() {
+		return types.ListNull(types.StringType), diags
+	}
+	if r.config == nil || model.SkipHolidaysCalendar.IsUnknown() || model.SkipHolidaysHorizonDays.IsUnknown() ||
+		model.Start.IsUnknown() || model.Timezone.IsUnknown() || model.Recurrence.IsUnknown() ||
+		model.Schedule.IsUnknown() {
+		return types.ListUnknown(types.StringType), diags
+	}
 
-	for i, line := range recurrence {
-		if !strings.HasPrefix(line, "RRULE:") {
-			capped[i] = line
-			continue
-		}
+	recurrence, diags := recurrenceLines(ctx, model)
+	if diags.HasError() || recurrence == nil {
+		return types.ListNull(types.StringType), diags
+	}
 
-		var parts []string
-		for _, part := range strings.Split(strings.TrimPrefix(line, "RRULE:"), ";") {
-			if strings.HasPrefix(part, "UNTIL=") || strings.HasPrefix(part, "COUNT=") {
-				continue
+	timezone := model.Timezone.ValueString()
+	loc, err := time.LoadLocation(timezone)
+	if err != nil {
+		// ValidateConfig reports this
+		return types.ListUnknown(types.StringType), diags
+	}
+	from := time.Now().In(loc)
+	to := from.AddDate(0, 0, int(model.SkipHolidaysHorizonDays.ValueInt64()))
+
+	holidays, err := r.listHolidays(ctx, model.SkipHolidaysCalendar.ValueString(), from, to)
+	if err != nil {
+		diags.AddAttributeError(
+			fwpath.Root("skip_holidays_calendar"),
+			"Error listing holidays",
+			fmt.Sprintf("Could not list the events on %s: %s", model.SkipHolidaysCalendar.ValueString(), err),
+		)
+		return types.ListNull(types.StringType), diags
+	}
+
+	start := &calendar.EventDateTime{DateTime: model.Start.ValueString(), TimeZone: timezone}
+	days, err := occurringDays(recurrence, start, holidays, timezone)
+	if err != nil {
+		diags.AddAttributeError(
+			fwpath.Root("skip_holidays_calendar"),
+			"Error expanding recurrence",
+			fmt.Sprintf("Could not work out which occurrences fall on holidays: %s", err),
+		)
+		return types.ListNull(types.StringType), diags
+	}
+
+	// Keep the holidays already skipped, or their occurrences would come
+	// back once they're in the past
+	if !prior.IsNull() && !prior.IsUnknown() {
+		var past []string
+		diags = append(diags, prior.ElementsAs(ctx, &past, false)...)
+		for _, day := range past {
+			if day < from.Format(time.DateOnly) && !slices.Contains(days, day) {
+				days = append(days, day)
 			}
-			parts = append(parts, part)
 		}
-		parts = append(parts, "UNTIL="+until)
-
-		capped[i] = "RRULE:" + strings.Join(parts, ";")
+		slices.Sort(days)
 	}
 
-	return capped
+	list, d := types.ListValueFrom(ctx, types.StringType, days)
+	diags = append(diags, d...)
+	return list, diags
 }
 
-// ImportState imports an existing event by its Google Calendar event ID.
-func (r *eventResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
-	// The import ID is the Google Calendar event ID, which is stored as the resource ID
-	resource.ImportStatePassthroughID(ctx, fwpath.Root("id"), req, resp)
+// listHolidays returns the dates of the all-day events on calendarID between
+// from and to.
+func (r *eventResource) listHolidays(ctx context.Context, calendarID string, from, to time.Time) ([]string, error) {
+
+	var events []*calendar.Event
+	err := r.config.calendar.Events.List(calendarID).
+		ShowDeleted(false).
+		SingleEvents(true).
+		TimeMin(from.Format(time.RFC3339)).
+		TimeMax(to.Format(time.RFC3339)).
+		Pages(ctx, func(page *calendar.Events) error {
+			events = append(events, page.Items...)
+			return nil
+		})
+	if err != nil {
+		return nil, err
+	}
+
+	return allDayDates(events), nil
 }
 
-// buildEvent builds a calendar.Event from the Terraform model.
-func (r *eventResource) buildEvent(ctx context.Context, model *eventResourceModel, event *calendar.Event) (*calendar.Event, diag.Diagnostics) {
+// skippedOutOfOffice returns the next out_of_office_lookahead occurrences of
+// the model that the organizer or a required attendee who hasn't declined is
+// out of office during, along with any in prior that have already passed.
+// Calendars whose events can't be read are checked through free/busy
+// instead; ones that can't be checked at all produce a warning and are left
+// out. It's unknown until everything it depends on is.
+func (r *eventResource) skippedOutOfOffice(ctx context.Context, model *eventResourceModel, prior types.List) (types.List, diag.Diagnostics) {
 	var diags diag.Diagnostics
 
-	// Set basic fields
-	event.Summary = model.Summary.ValueString()
-	event.Location = model.Location.ValueString()
-	event.Description = model.Description.ValueString()
-
-	guestsCanInviteOthers := model.GuestsCanInviteOthers.ValueBool()
-	event.GuestsCanInviteOthers = &guestsCanInviteOthers
-	event.GuestsCanModify = model.GuestsCanModify.ValueBool()
-	guestsCanSeeOtherGuests := model.GuestsCanSeeOtherGuests.ValueBool()
-	event.GuestsCanSeeOtherGuests = &guestsCanSeeOtherGuests
-
-	showAsAvailable := model.ShowAsAvailable.ValueBool()
-	event.Transparency = boolToTransparency(showAsAvailable)
-	event.Visibility = model.Visibility.ValueString()
-
-	// Set date/time fields
-	event.Start = &calendar.EventDateTime{
-		DateTime: model.Start.ValueString(),
-		TimeZone: model.Timezone.ValueString(),
+	if !model.SkipOutOfOffice.ValueBool() {
+		if model.SkipOutOfOffice.IsUnknown() {
+			return types.ListUnknown(types.StringType), diags
+		}
+		return types.ListNull(types.StringType), diags
 	}
-	event.End = &calendar.EventDateTime{
-		DateTime: model.End.ValueString(),
-		TimeZone: model.Timezone.ValueString(),
+	if r.config == nil || model.OutOfOfficeLookahead.IsUnknown() || model.Start.IsUnknown() ||
+		model.End.IsUnknown() || model.Timezone.IsUnknown() || model.Recurrence.IsUnknown() ||
+		model.Schedule.IsUnknown() || model.Attendees.IsUnknown() {
+		return types.ListUnknown(types.StringType), diags
 	}
 
-	// Set recurrence
-	if !model.Recurrence.IsNull() {
-		var recurrence []string
-		diags = append(diags, model.Recurrence.ElementsAs(ctx, &recurrence, false)...)
-		event.Recurrence = recurrence
+	recurrence, diags := recurrenceLines(ctx, model)
+	if diags.HasError() || recurrence == nil {
+		return types.ListNull(types.StringType), diags
 	}
 
-	// Set conference data
-	if !model.Conference.IsNull() && !model.Conference.IsUnknown() {
-		var conference map[string]string
-		diags = append(diags, model.Conference.ElementsAs(ctx, &conference, false)...)
-		if googleMeetID, ok := conference["google_meet_id"]; ok && googleMeetID != "" {
-			event.ConferenceData = &calendar.ConferenceData{
-				ConferenceSolution: &calendar.ConferenceSolution{
-					Key: &calendar.ConferenceSolutionKey{
-						Type: "hangoutsMeet",
-					},
-				},
-				EntryPoints: []*calendar.EntryPoint{
-					{
-						EntryPointType: "video",
-						Label:          fmt.Sprintf("meet.google.com/%s", googleMeetID),
-						Uri:            fmt.Sprintf("https://meet.google.com/%s", googleMeetID),
-					},
-				},
-			}
-		}
+	timezone := model.Timezone.ValueString()
+	loc, err := time.LoadLocation(timezone)
+	if err != nil {
+		// ValidateConfig reports this
+		return types.ListUnknown(types.StringType), diags
+	}
+	start := &calendar.EventDateTime{DateTime: model.Start.ValueString(), TimeZone: timezone}
+	set, err := recurrenceSet(recurrence, start)
+	if err != nil {
+		diags.AddAttributeError(
+			fwpath.Root("skip_when_attendee_out_of_office"),
+			"Error expanding recurrence",
+			fmt.Sprintf("Could not work out the upcoming occurrences to check: %s", err),
+		)
+		return types.ListNull(types.StringType), diags
 	}
 
-	// Set attendees
-	if !model.Attendees.IsNull() && !model.Attendees.IsUnknown() {
-		var attendees []attendeeModel
-		diags = append(diags, model.Attendees.ElementsAs(ctx, &attendees, false)...)
-
-		attendeesExisting := event.Attendees
-		apiAttendees := make([]*calendar.EventAttendee, len(attendees))
-
-		for i, att := range attendees {
-			apiAttendees[i] = &calendar.EventAttendee{
-				Email: att.Email.ValueString(),
-			}
-			// If attendee is already on the event, preserve their existing attributes
-			for _, ea := range attendeesExisting {
-				if ea.Email == apiAttendees[i].Email {
-					apiAttendees[i] = ea
-					break
+	now := time.Now()
+	occurrences := upcomingOccurrences(set, now, int(model.OutOfOfficeLookahead.ValueInt64()))
+	duration := eventDuration(model.Start.ValueString(), model.End.ValueString())
+
+	var skipped []string
+	if len(occurrences) > 0 {
+		calendars := []string{"primary"}
+		if !model.Attendees.IsNull() {
+			var attendees []attendeeModel
+			diags = append(diags, model.Attendees.ElementsAs(ctx, &attendees, false)...)
+			for _, a := range attendees {
+				// The meeting goes ahead without optional guests and those who
+				// declined it anyway
+				if a.Resource.ValueBool() || a.Optional.ValueBool() || a.ResponseStatus.ValueString() == "declined" {
+					continue
 				}
+				calendars = append(calendars, a.Email.ValueString())
+			}
+		}
+
+		from, to := occurrences[0], occurrences[len(occurrences)-1].Add(duration)
+		var away []skipWindow
+		for _, id := range calendars {
+			events, err := r.listOutOfOffice(ctx, id, from, to)
+			if err == nil {
+				away = append(away, eventWindows(events, loc)...)
+				continue
+			}
+			busy, busyErr := r.listBusyDays(ctx, id, from, to, duration)
+			if busyErr != nil {
+				diags.AddAttributeWarning(
+					fwpath.Root("skip_when_attendee_out_of_office"),
+					"Could not check out of office",
+					fmt.Sprintf("Could not list the out-of-office events on %s (%s) or its free/busy (%s), "+
+						"so occurrences it's out of office for aren't skipped.", id, err, busyErr),
+				)
+				continue
+			}
+			away = append(away, busy...)
+		}
+
+		for _, t := range overlapping(occurrences, duration, away) {
+			skipped = append(skipped, t.In(loc).Format(time.RFC3339))
+		}
+	}
+
+	// Keep the occurrences already skipped, or they'd come back once they're
+	// in the past
+	if !prior.IsNull() && !prior.IsUnknown() {
+		var past []string
+		diags = append(diags, prior.ElementsAs(ctx, &past, false)...)
+		for _, s := range past {
+			if t, err := time.Parse(time.RFC3339, s); err == nil && t.Before(now) && !slices.Contains(skipped, s) {
+				skipped = append(skipped, s)
+			}
+		}
+	}
+	slices.SortFunc(skipped, func(a, b string) int {
+		ta, _ := time.Parse(time.RFC3339, a)
+		tb, _ := time.Parse(time.RFC3339, b)
+		return ta.Compare(tb)
+	})
+
+	list, d := types.ListValueFrom(ctx, types.StringType, skipped)
+	diags = append(diags, d...)
+	return list, diags
+}
+
+// listOutOfOffice returns the out-of-office events on calendarID between
+// from and to.
+func (r *eventResource) listOutOfOffice(ctx context.Context, calendarID string, from, to time.Time) ([]*calendar.Event, error) {
+
+	var events []*calendar.Event
+	err := r.config.calendar.Events.List(calendarID).
+		EventTypes("outOfOffice").
+		ShowDeleted(false).
+		SingleEvents(true).
+		TimeMin(from.Format(time.RFC3339)).
+		TimeMax(to.Format(time.RFC3339)).
+		Pages(ctx, func(page *calendar.Events) error {
+			events = append(events, page.Items...)
+			return nil
+		})
+	if err != nil {
+		return nil, err
+	}
+
+	return events, nil
+}
+
+// listBusyDays returns the spans calendarID is busy for between from and to
+// that last a day or more, and longer than duration - the event's own
+// occurrences, or ordinary meetings, would otherwise count - taking them as
+// time out of office. It's for calendars whose events can't be listed, whose
+// free/busy usually can be.
+func (r *eventResource) listBusyDays(ctx context.Context, calendarID string, from, to time.Time, duration time.Duration) ([]skipWindow, error) {
+
+	resp, err := r.config.calendar.Freebusy.Query(&calendar.FreeBusyRequest{
+		TimeMin: from.Format(time.RFC3339),
+		TimeMax: to.Format(time.RFC3339),
+		Items:   []*calendar.FreeBusyRequestItem{{Id: calendarID}},
+	}).Context(ctx).Do()
+	if err != nil {
+		return nil, err
+	}
+
+	busy, ok := resp.Calendars[calendarID]
+	if !ok {
+		return nil, fmt.Errorf("no free/busy returned for %s", calendarID)
+	}
+	if len(busy.Errors) > 0 {
+		return nil, fmt.Errorf("%s", busy.Errors[0].Reason)
+	}
+
+	var windows []skipWindow
+	for _, period := range busy.Busy {
+		start, err := time.Parse(time.RFC3339, period.Start)
+		if err != nil {
+			continue
+		}
+		end, err := time.Parse(time.RFC3339, period.End)
+		if err != nil {
+			continue
+		}
+		if length := end.Sub(start); length >= 24*time.Hour && length > duration {
+			windows = append(windows, skipWindow{from: start, to: end})
+		}
+	}
+
+	return windows, nil
+}
+
+// capRecurrenceUntil replaces (or adds) the UNTIL component of each RRULE
+// line with boundary, leaving EXRULE/RDATE/EXDATE lines untouched.
+func capRecurrenceUntil(recurrence []string, boundary time.Time) []string {
+
+	until := boundary.Format("20060102T150405Z")
+	capped := make([]string, len(recurrence))
+
+	for i, line := range recurrence {
+		if !strings.HasPrefix(line, "RRULE:") {
+			capped[i] = line
+			continue
+		}
+
+		var parts []string
+		for _, part := range strings.Split(strings.TrimPrefix(line, "RRULE:"), ";") {
+			if strings.HasPrefix(part, "UNTIL=") || strings.HasPrefix(part, "COUNT=") {
+				continue
+			}
+			parts = append(parts, part)
+		}
+		parts = append(parts, "UNTIL="+until)
+
+		capped[i] = "RRULE:" + strings.Join(parts, ";")
+	}
+
+	return capped
+}
+
+// ImportState imports an existing event by its Google Calendar event ID.
+func (r *eventResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	// The import ID is the Google Calendar event ID, which is stored as the resource ID
+	resource.ImportStatePassthroughID(ctx, fwpath.Root("id"), req, resp)
+}
+
+// UpgradeState upgrades state written by earlier versions of the schema.
+func (r *eventResource) UpgradeState(ctx context.Context) map[int64]resource.StateUpgrader {
+	return map[int64]resource.StateUpgrader{
+		// Version 0 stored conference as a map of strings rather than a block
+		0: {
+			StateUpgrader: func(ctx context.Context, req resource.UpgradeStateRequest, resp *resource.UpgradeStateResponse) {
+				if req.RawState == nil {
+					return
+				}
+
+				upgraded, err := upgradeEventStateV0(req.RawState.JSON)
+				if err != nil {
+					resp.Diagnostics.AddError(
+						"Error upgrading event state",
+						fmt.Sprintf("Could not upgrade the conference map to a block: %s", err),
+					)
+					return
+				}
+
+				resp.DynamicValue = &tfprotov6.DynamicValue{JSON: upgraded}
+			},
+		},
+	}
+}
+
+// upgradeEventStateV0 rewrites version 0 state JSON, whose conference was a
+// map holding google_meet_id or create_google_meet = "true", into the
+// conference block. Attributes the block adds are left for the next refresh
+// to fill in.
+func upgradeEventStateV0(raw []byte) ([]byte, error) {
+
+	var state map[string]json.RawMessage
+	if err := json.Unmarshal(raw, &state); err != nil {
+		return nil, err
+	}
+
+	var conference map[string]string
+	if v, ok := state["conference"]; ok {
+		if err := json.Unmarshal(v, &conference); err != nil {
+			return nil, fmt.Errorf("reading conference: %w", err)
+		}
+	}
+
+	block := map[string]any{}
+	if id := conference["google_meet_id"]; id != "" {
+		block["solution_type"] = "hangoutsMeet"
+		block["conference_id"] = id
+	}
+	if conference["create_google_meet"] == "true" {
+		block["solution_type"] = "hangoutsMeet"
+		block["create_google_meet"] = true
+	}
+
+	state["conference"] = json.RawMessage("null")
+	if len(block) > 0 {
+		upgraded, err := json.Marshal(block)
+		if err != nil {
+			return nil, err
+		}
+		state["conference"] = upgraded
+	}
+
+	return json.Marshal(state)
+}
+
+// buildEvent builds a calendar.Event from the Terraform model.
+func (r *eventResource) buildEvent(ctx context.Context, model *eventResourceModel, event *calendar.Event) (*calendar.Event, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	// Set basic fields
+	event.Summary = model.Summary.ValueString()
+	event.Location = model.Location.ValueString()
+	event.Description = renderDescription(model.Description.ValueString(), model.DescriptionFormat.ValueString())
+
+	guestsCanInviteOthers := model.GuestsCanInviteOthers.ValueBool()
+	event.GuestsCanInviteOthers = &guestsCanInviteOthers
+	event.GuestsCanModify = model.GuestsCanModify.ValueBool()
+	guestsCanSeeOtherGuests := model.GuestsCanSeeOtherGuests.ValueBool()
+	event.GuestsCanSeeOtherGuests = &guestsCanSeeOtherGuests
+
+	showAsAvailable := model.ShowAsAvailable.ValueBool()
+	event.Transparency = boolToTransparency(showAsAvailable)
+	event.Visibility = model.Visibility.ValueString()
+
+	// Set date/time fields and recurrence
+	diags = append(diags, buildEventTime(ctx, model.Start, model.End, model.Timezone, model.Recurrence, event)...)
+
+	// Set recurrence from the schedule, when that's used instead
+	if !model.Schedule.IsNull() && !model.Schedule.IsUnknown() {
+		lines, d := recurrenceLines(ctx, model)
+		diags = append(diags, d...)
+		event.Recurrence = lines
+	}
+
+	// Exclude the occurrences falling on skipped days
+	diags = append(diags, r.buildSkips(ctx, model, event)...)
+
+	// End the series on ends_on
+	diags = append(diags, buildEndsOn(ctx, model, event)...)
+
+	// Set conference data
+	if !model.Conference.IsNull() && !model.Conference.IsUnknown() {
+		var conference conferenceModel
+		diags = append(diags, model.Conference.As(ctx, &conference, basetypes.ObjectAsOptions{})...)
+
+		if conference.CreateGoogleMeet.ValueBool() {
+			// Only ask for a new meeting when the event doesn't already have
+			// this one, so the meeting stays the same across updates. The
+			// request ID is set by setMeetRequestID.
+			if !isPlannedMeet(event.ConferenceData, conference.ConferenceID) {
+				event.ConferenceData = &calendar.ConferenceData{
+					CreateRequest: &calendar.CreateConferenceRequest{
+						ConferenceSolutionKey: &calendar.ConferenceSolutionKey{
+							Type: "hangoutsMeet",
+						},
+					},
+				}
+			}
+			event.ConferenceData.Notes = conference.Notes.ValueString()
+		} else {
+			conferenceData, d := buildConferenceData(ctx, &conference, event.ConferenceData)
+			diags = append(diags, d...)
+			event.ConferenceData = conferenceData
+		}
+	} else if model.Conference.IsNull() && event.ConferenceData != nil {
+		// Drop a conference removed from the configuration. Events.Update
+		// only clears it when sent as an explicit null.
+		event.ConferenceData = nil
+		event.NullFields = append(event.NullFields, "ConferenceData")
+	}
+
+	// Set attendees
+	if !model.Attendees.IsNull() && !model.Attendees.IsUnknown() {
+		var attendees []attendeeModel
+		diags = append(diags, model.Attendees.ElementsAs(ctx, &attendees, false)...)
+
+		attendeesExisting := event.Attendees
+		apiAttendees := make([]*calendar.EventAttendee, len(attendees))
+
+		for i, att := range attendees {
+			apiAttendees[i] = &calendar.EventAttendee{
+				Email: att.Email.ValueString(),
+			}
+			// If attendee is already on the event, preserve their existing attributes
+			for _, ea := range attendeesExisting {
+				if r.config.sameAddress(ctx, ea.Email, apiAttendees[i].Email) {
+					apiAttendees[i] = ea
+					break
+				}
+			}
+			// Set the fields managed by the provider
+			apiAttendees[i].Optional = att.Optional.ValueBool()
+			if !att.DisplayName.IsNull() && !att.DisplayName.IsUnknown() {
+				apiAttendees[i].DisplayName = att.DisplayName.ValueString()
+			}
+			if !att.Comment.IsNull() && !att.Comment.IsUnknown() {
+				apiAttendees[i].Comment = att.Comment.ValueString()
+			}
+			if !att.AdditionalGuests.IsNull() && !att.AdditionalGuests.IsUnknown() {
+				apiAttendees[i].AdditionalGuests = att.AdditionalGuests.ValueInt64()
 			}
-			// Set the optional field (this is managed by the provider)
-			apiAttendees[i].Optional = att.Optional.ValueBool()
 		}
 
 		event.Attendees = apiAttendees
 	}
 
+	// Set rooms, which are attendees flagged as resources
+	if !model.Rooms.IsNull() && !model.Rooms.IsUnknown() {
+		var rooms []roomModel
+		diags = append(diags, model.Rooms.ElementsAs(ctx, &rooms, false)...)
+
+		for _, room := range rooms {
+			email := room.Email.ValueString()
+
+			apiRoom := &calendar.EventAttendee{Email: email}
+			for i, ea := range event.Attendees {
+				if strings.EqualFold(ea.Email, email) {
+					// Preserve the room's existing response
+					apiRoom = ea
+					event.Attendees = append(event.Attendees[:i], event.Attendees[i+1:]...)
+					break
+				}
+			}
+			apiRoom.Resource = true
+
+			event.Attendees = append(event.Attendees, apiRoom)
+		}
+	}
+
 	// Set attachments
 	if !model.Attachments.IsNull() && !model.Attachments.IsUnknown() {
 		var attachments []attachmentModel
@@ -807,9 +2167,591 @@ func (r *eventResource) buildEvent(ctx context.Context, model *eventResourceMode
 		event.Attachments = apiAttachments
 	}
 
+	// Set extended properties, merged with any keys other apps have set
+	if !model.ExtendedProperties.IsNull() && !model.ExtendedProperties.IsUnknown() {
+		var extendedProperties extendedPropertiesModel
+		diags = append(diags, model.ExtendedProperties.As(ctx, &extendedProperties, basetypes.ObjectAsOptions{})...)
+
+		var private, shared map[string]string
+		if !extendedProperties.Private.IsNull() && !extendedProperties.Private.IsUnknown() {
+			diags = append(diags, extendedProperties.Private.ElementsAs(ctx, &private, false)...)
+		}
+		if !extendedProperties.Shared.IsNull() && !extendedProperties.Shared.IsUnknown() {
+			diags = append(diags, extendedProperties.Shared.ElementsAs(ctx, &shared, false)...)
+		}
+
+		if event.ExtendedProperties == nil {
+			event.ExtendedProperties = &calendar.EventExtendedProperties{}
+		}
+		event.ExtendedProperties.Private = mergeProperties(event.ExtendedProperties.Private, private)
+		event.ExtendedProperties.Shared = mergeProperties(event.ExtendedProperties.Shared, shared)
+	}
+
+	// Set source
+	if !model.Source.IsNull() && !model.Source.IsUnknown() {
+		var source sourceModel
+		diags = append(diags, model.Source.As(ctx, &source, basetypes.ObjectAsOptions{})...)
+		event.Source = &calendar.EventSource{
+			Title: source.Title.ValueString(),
+			Url:   source.URL.ValueString(),
+		}
+	} else {
+		event.Source = nil
+	}
+
 	return event, diags
 }
 
+// buildSkips appends an EXDATE line to the event's recurrence for the
+// occurrences falling in the model's skip blocks or on its skipped holidays,
+// and those skipped for someone being out of office. Calendars that couldn't
+// be searched at plan time are searched now.
+func (r *eventResource) buildSkips(ctx context.Context, model *eventResourceModel, event *calendar.Event) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	if model.SkippedHolidays.IsUnknown() {
+		holidays, d := r.skippedHolidays(ctx, model, types.ListNull(types.StringType))
+		diags = append(diags, d...)
+		model.SkippedHolidays = holidays
+	}
+	if model.SkippedOutOfOffice.IsUnknown() {
+		outOfOffice, d := r.skippedOutOfOffice(ctx, model, types.ListNull(types.StringType))
+		diags = append(diags, d...)
+		model.SkippedOutOfOffice = outOfOffice
+	}
+
+	return append(diags, applySkips(ctx, model, event)...)
+}
+
+// applySkips appends the EXDATE line for buildSkips, once the model's skipped
+// holidays and out-of-office occurrences are known.
+func applySkips(ctx context.Context, model *eventResourceModel, event *calendar.Event) diag.Diagnostics {
+	lines, diags := recurrenceLines(ctx, model)
+	if diags.HasError() || lines == nil {
+		return diags
+	}
+
+	windows, d := modelSkipWindows(ctx, model, model.Timezone.ValueString())
+	diags = append(diags, d...)
+	if diags.HasError() {
+		return diags
+	}
+
+	skipped, err := skippedOccurrences(lines, event.Start, windows)
+	if err != nil {
+		diags.AddAttributeError(
+			fwpath.Root("skip"),
+			"Error expanding recurrence",
+			fmt.Sprintf("Could not work out which occurrences to skip: %s", err),
+		)
+		return diags
+	}
+	if len(skipped) > 0 {
+		event.Recurrence = append(lines, exdateLine(skipped, model.Timezone.ValueString()))
+	}
+
+	return diags
+}
+
+// buildEndsOn caps the event's rules with an UNTIL just before the first
+// occurrence on or after the model's ends_on. A series that's over by then
+// is left as it is.
+func buildEndsOn(ctx context.Context, model *eventResourceModel, event *calendar.Event) diag.Diagnostics {
+	if model.EndsOn.IsNull() || model.EndsOn.IsUnknown() {
+		return nil
+	}
+
+	lines, diags := recurrenceLines(ctx, model)
+	if diags.HasError() || lines == nil {
+		return diags
+	}
+
+	boundary, err := endsOnBoundary(lines, event.Start, model.EndsOn.ValueString())
+	if err != nil {
+		diags.AddAttributeError(
+			fwpath.Root("ends_on"),
+			"Error expanding recurrence",
+			fmt.Sprintf("Could not work out where to end the series: %s", err),
+		)
+		return diags
+	}
+	if boundary != nil {
+		event.Recurrence = capRecurrenceUntil(event.Recurrence, *boundary)
+	}
+
+	return diags
+}
+
+// modelSkipWindows returns the windows of the model's skip blocks, skipped
+// holidays and out-of-office occurrences, in timezone.
+func modelSkipWindows(ctx context.Context, model *eventResourceModel, timezone string) ([]skipWindow, diag.Diagnostics) {
+	windows, diags := skipWindows(ctx, model.Skips, timezone)
+	if diags.HasError() {
+		return windows, diags
+	}
+
+	if !model.SkippedHolidays.IsNull() && !model.SkippedHolidays.IsUnknown() {
+		var holidays []string
+		diags = append(diags, model.SkippedHolidays.ElementsAs(ctx, &holidays, false)...)
+		holidayWindows, err := dayWindows(holidays, timezone)
+		if err != nil {
+			diags.AddAttributeError(fwpath.Root("skipped_holidays"), "Invalid Holiday", err.Error())
+			return windows, diags
+		}
+		windows = append(windows, holidayWindows...)
+	}
+
+	if !model.SkippedOutOfOffice.IsNull() && !model.SkippedOutOfOffice.IsUnknown() {
+		var occurrences []string
+		diags = append(diags, model.SkippedOutOfOffice.ElementsAs(ctx, &occurrences, false)...)
+		occurrenceWindows, err := instantWindows(occurrences)
+		if err != nil {
+			diags.AddAttributeError(fwpath.Root("skipped_out_of_office"), "Invalid Occurrence", err.Error())
+			return windows, diags
+		}
+		windows = append(windows, occurrenceWindows...)
+	}
+
+	return windows, diags
+}
+
+// waitForRooms polls the event until every room in the model that isn't in
+// prior - the rooms already booked - has accepted or declined it, or
+// room_response_timeout passes, and returns the latest copy of the event. A
+// room declining - typically because it's already booked for some or all of
+// the event's occurrences - is an error; running out of time is only a
+// warning, since rooms can be slow to respond.
+func (r *eventResource) waitForRooms(ctx context.Context, model *eventResourceModel, prior types.Set, event *calendar.Event) (*calendar.Event, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	booked, d := roomSetEmails(ctx, prior)
+	diags = append(diags, d...)
+	wanted, d := roomSetEmails(ctx, model.Rooms)
+	diags = append(diags, d...)
+	if diags.HasError() {
+		return event, diags
+	}
+
+	var emails []string
+	for _, email := range wanted {
+		if !slices.ContainsFunc(booked, func(b string) bool { return strings.EqualFold(b, email) }) {
+			emails = append(emails, email)
+		}
+	}
+	if len(emails) == 0 {
+		return event, diags
+	}
+
+	timeout, err := time.ParseDuration(model.RoomResponseTimeout.ValueString())
+	if err != nil {
+		diags.AddError(
+			"Invalid room_response_timeout",
+			fmt.Sprintf("Could not parse %q: %s", model.RoomResponseTimeout.ValueString(), err),
+		)
+		return event, diags
+	}
+	deadline := time.Now().Add(timeout)
+
+	for {
+		pending, declined := roomResponses(event, emails)
+
+		if len(declined) > 0 {
+			diags.AddError(
+				"Meeting room declined event",
+				fmt.Sprintf("%s declined %q (id %s). Rooms decline invitations that conflict with "+
+					"existing bookings - pick another room or time, or check the room's calendar at %s.",
+					strings.Join(declined, ", "), event.Summary, event.Id, event.HtmlLink),
+			)
+			return event, diags
+		}
+		if len(pending) == 0 {
+			return event, diags
+		}
+		if time.Now().After(deadline) {
+			diags.AddWarning(
+				"Meeting room has not responded",
+				fmt.Sprintf("%s had not accepted %q (id %s) after %s. The booking may still be "+
+					"processing; the next refresh will show its response.",
+					strings.Join(pending, ", "), event.Summary, event.Id, timeout),
+			)
+			return event, diags
+		}
+
+		select {
+		case <-ctx.Done():
+			diags.AddError(
+				"Error waiting for meeting rooms",
+				fmt.Sprintf("Stopped waiting for %s to respond: %s", strings.Join(pending, ", "), ctx.Err()),
+			)
+			return event, diags
+		case <-time.After(roomPollInterval):
+		}
+
+		latest, err := r.config.calendar.Events.
+			Get("primary", event.Id).
+			MaxAttendees(maxAttendees(model)).
+			Do()
+		if err != nil {
+			diags.AddError(
+				"Error waiting for meeting rooms",
+				fmt.Sprintf("Could not read event %s: %s", event.Id, err),
+			)
+			return event, diags
+		}
+		event = latest
+	}
+}
+
+// readOccurrences updates the model's next, upcoming and last occurrences
+// from the event's recurrence. They're left null if it can't be expanded.
+func readOccurrences(model *eventResourceModel, event *calendar.Event) {
+	model.NextOccurrence = types.StringNull()
+	model.UpcomingOccurrences = types.ListNull(types.StringType)
+	model.LastOccurrence = types.StringNull()
+
+	set, err := recurrenceSet(event.Recurrence, event.Start)
+	if err != nil {
+		return
+	}
+
+	format := func(t time.Time) string {
+		return inTimeZone(t.Format(time.RFC3339), event.Start.TimeZone)
+	}
+
+	n := int64(defaultUpcomingOccurrences)
+	if !model.UpcomingCount.IsNull() && !model.UpcomingCount.IsUnknown() {
+		n = model.UpcomingCount.ValueInt64()
+	}
+	now := time.Now()
+
+	if next := nextOccurrence(set, now); next != nil {
+		model.NextOccurrence = types.StringValue(format(*next))
+	}
+
+	upcoming := upcomingOccurrences(set, now, int(n))
+	upcomingList := make([]attr.Value, len(upcoming))
+	for i, t := range upcoming {
+		upcomingList[i] = types.StringValue(format(t))
+	}
+	model.UpcomingOccurrences, _ = types.ListValue(types.StringType, upcomingList)
+
+	if last := lastOccurrence(set); last != nil {
+		model.LastOccurrence = types.StringValue(format(*last))
+	}
+}
+
+// maxAttendees returns the model's max_attendees, falling back to the
+// default when it isn't set.
+func maxAttendees(model *eventResourceModel) int64 {
+	if model.MaxAttendees.IsNull() || model.MaxAttendees.IsUnknown() {
+		return defaultMaxAttendees
+	}
+	return model.MaxAttendees.ValueInt64()
+}
+
+// sendUpdates returns who the API should notify about changes to the model's
+// event: send_updates when set, otherwise the equivalent of the deprecated
+// send_notifications.
+func sendUpdates(model *eventResourceModel) string {
+	if !model.SendUpdates.IsNull() && !model.SendUpdates.IsUnknown() {
+		return model.SendUpdates.ValueString()
+	}
+	if model.SendNotifications.IsNull() || model.SendNotifications.ValueBool() {
+		return "all"
+	}
+	return "none"
+}
+
+// roomResponses sorts the given room emails by the event's copy of their
+// response: pending if they haven't accepted or declined yet (or aren't on
+// the event at all), declined if they turned it down.
+func roomResponses(event *calendar.Event, rooms []string) (pending, declined []string) {
+
+	for _, room := range rooms {
+		status := "needsAction"
+		for _, att := range event.Attendees {
+			if strings.EqualFold(att.Email, room) {
+				status = att.ResponseStatus
+				break
+			}
+		}
+
+		switch status {
+		case "accepted", "tentative":
+		case "declined":
+			declined = append(declined, room)
+		default:
+			pending = append(pending, room)
+		}
+	}
+
+	return pending, declined
+}
+
+// meetRequestIDKey is the private state key holding the request ID used to
+// ask for the event's Google Meet.
+const meetRequestIDKey = "meet_request_id"
+
+// privateState is the resource private state, as given to Create and Update.
+type privateState interface {
+	GetKey(ctx context.Context, key string) ([]byte, diag.Diagnostics)
+	SetKey(ctx context.Context, key string, value []byte) diag.Diagnostics
+}
+
+// isPlannedMeet reports whether existing is the Google Meet, created or still
+// pending, that the plan carries over from state: ModifyPlan leaves
+// conference_id unknown once the configured conference changes.
+func isPlannedMeet(existing *calendar.ConferenceData, conferenceID types.String) bool {
+	if existing == nil || conferenceID.IsUnknown() || existing.ConferenceId != conferenceID.ValueString() {
+		return false
+	}
+
+	switch {
+	case existing.ConferenceSolution != nil && existing.ConferenceSolution.Key != nil:
+		return existing.ConferenceSolution.Key.Type == "hangoutsMeet"
+	case existing.CreateRequest != nil && existing.CreateRequest.ConferenceSolutionKey != nil:
+		return existing.CreateRequest.ConferenceSolutionKey.Type == "hangoutsMeet"
+	}
+	return false
+}
+
+// setMeetRequestID sets the request ID of the event's request for a new
+// Google Meet, if it has one. The ID is random, so no two events share one,
+// and kept in private state until the meeting is created (see
+// clearMeetRequestID), so asking again meanwhile doesn't ask for a second one.
+func setMeetRequestID(ctx context.Context, private privateState, event *calendar.Event) diag.Diagnostics {
+	if event.ConferenceData == nil || event.ConferenceData.CreateRequest == nil || event.ConferenceData.CreateRequest.RequestId != "" {
+		return nil
+	}
+
+	value, diags := private.GetKey(ctx, meetRequestIDKey)
+	var id string
+	if value != nil {
+		if err := json.Unmarshal(value, &id); err != nil {
+			diags.AddError("Invalid private state", fmt.Sprintf("Could not read %s: %s", meetRequestIDKey, err))
+			return diags
+		}
+	}
+	if id == "" {
+		id = rand.Text()
+		value, _ = json.Marshal(id)
+		diags = append(diags, private.SetKey(ctx, meetRequestIDKey, value)...)
+	}
+
+	event.ConferenceData.CreateRequest.RequestId = id
+	return diags
+}
+
+// clearMeetRequestID drops the request ID kept by setMeetRequestID once the
+// event has no Google Meet pending, because it was created or the conference
+// removed. The API ignores a request ID it has already seen, so the next
+// Meet asked for needs a new one.
+func clearMeetRequestID(ctx context.Context, private privateState, event *calendar.Event) diag.Diagnostics {
+	if c := event.ConferenceData; c != nil && c.CreateRequest != nil && c.CreateRequest.Status != nil && c.CreateRequest.Status.StatusCode == "pending" {
+		return nil
+	}
+	return private.SetKey(ctx, meetRequestIDKey, nil)
+}
+
+// buildConferenceData builds the conference data for an existing conference
+// from the model. Entry points the model leaves out are kept from existing
+// when it's the same conference; failing that, a Google Meet given only by
+// its ID gets the video entry point Google itself would list for it.
+func buildConferenceData(ctx context.Context, conference *conferenceModel, existing *calendar.ConferenceData) (*calendar.ConferenceData, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	solutionType := "hangoutsMeet"
+	if !conference.SolutionType.IsNull() && !conference.SolutionType.IsUnknown() {
+		solutionType = conference.SolutionType.ValueString()
+	}
+
+	conferenceData := &calendar.ConferenceData{
+		ConferenceSolution: &calendar.ConferenceSolution{
+			Key: &calendar.ConferenceSolutionKey{
+				Type: solutionType,
+			},
+		},
+		Notes: conference.Notes.ValueString(),
+	}
+	if !conference.SolutionName.IsUnknown() {
+		conferenceData.ConferenceSolution.Name = conference.SolutionName.ValueString()
+	}
+	if !conference.ConferenceID.IsUnknown() {
+		conferenceData.ConferenceId = conference.ConferenceID.ValueString()
+	}
+
+	if !conference.EntryPoints.IsNull() && !conference.EntryPoints.IsUnknown() {
+		var entryPoints []entryPointModel
+		diags = append(diags, conference.EntryPoints.ElementsAs(ctx, &entryPoints, false)...)
+
+		for _, ep := range entryPoints {
+			conferenceData.EntryPoints = append(conferenceData.EntryPoints, &calendar.EntryPoint{
+				EntryPointType: ep.EntryPointType.ValueString(),
+				Uri:            ep.URI.ValueString(),
+				Label:          ep.Label.ValueString(),
+				Pin:            ep.Pin.ValueString(),
+				Passcode:       ep.Passcode.ValueString(),
+				AccessCode:     ep.AccessCode.ValueString(),
+				MeetingCode:    ep.MeetingCode.ValueString(),
+				Password:       ep.Password.ValueString(),
+				RegionCode:     ep.RegionCode.ValueString(),
+			})
+		}
+	} else if existing != nil && existing.ConferenceId != "" && existing.ConferenceId == conferenceData.ConferenceId {
+		conferenceData.EntryPoints = existing.EntryPoints
+	} else if solutionType == "hangoutsMeet" && conferenceData.ConferenceId != "" {
+		conferenceData.EntryPoints = []*calendar.EntryPoint{
+			{
+				EntryPointType: "video",
+				Label:          fmt.Sprintf("meet.google.com/%s", conferenceData.ConferenceId),
+				Uri:            fmt.Sprintf("https://meet.google.com/%s", conferenceData.ConferenceId),
+			},
+		}
+	}
+
+	if len(conferenceData.EntryPoints) == 0 {
+		diags.AddAttributeError(
+			fwpath.Root("conference"),
+			"Incomplete conference settings",
+			fmt.Sprintf("A %s conference needs entry_points, or create_google_meet for a new Google Meet.", solutionType),
+		)
+	}
+
+	return conferenceData, diags
+}
+
+// conferenceValue converts the event's conference data into the conference
+// nested object, keeping the model's create_google_meet setting.
+func conferenceValue(conferenceData *calendar.ConferenceData, createGoogleMeet types.Bool) types.Object {
+
+	solutionType, solutionName := types.StringNull(), types.StringNull()
+	switch {
+	case conferenceData.ConferenceSolution != nil:
+		if conferenceData.ConferenceSolution.Key != nil {
+			solutionType = optionalString(conferenceData.ConferenceSolution.Key.Type)
+		}
+		solutionName = optionalString(conferenceData.ConferenceSolution.Name)
+	case conferenceData.CreateRequest != nil && conferenceData.CreateRequest.ConferenceSolutionKey != nil:
+		// The meeting hasn't been created yet
+		solutionType = optionalString(conferenceData.CreateRequest.ConferenceSolutionKey.Type)
+	}
+
+	entryPointObjectType := types.ObjectType{AttrTypes: entryPointAttrTypes}
+	entryPointList := make([]attr.Value, len(conferenceData.EntryPoints))
+	for i, ep := range conferenceData.EntryPoints {
+		entryPointList[i], _ = types.ObjectValue(
+			entryPointAttrTypes,
+			map[string]attr.Value{
+				"entry_point_type": types.StringValue(ep.EntryPointType),
+				"uri":              types.StringValue(ep.Uri),
+				"label":            optionalString(ep.Label),
+				"pin":              optionalString(ep.Pin),
+				"passcode":         optionalString(ep.Passcode),
+				"access_code":      optionalString(ep.AccessCode),
+				"meeting_code":     optionalString(ep.MeetingCode),
+				"password":         optionalString(ep.Password),
+				"region_code":      optionalString(ep.RegionCode),
+			},
+		)
+	}
+	entryPoints, _ := types.ListValue(entryPointObjectType, entryPointList)
+
+	conference, _ := types.ObjectValue(
+		conferenceAttrTypes,
+		map[string]attr.Value{
+			"solution_type":      solutionType,
+			"solution_name":      solutionName,
+			"conference_id":      optionalString(conferenceData.ConferenceId),
+			"create_google_meet": createGoogleMeet,
+			"notes":              optionalString(conferenceData.Notes),
+			"entry_points":       entryPoints,
+		},
+	)
+	return conference
+}
+
+// mergeProperties returns existing with desired's keys added or overwritten,
+// leaving any other keys in place.
+func mergeProperties(existing, desired map[string]string) map[string]string {
+
+	merged := make(map[string]string, len(existing)+len(desired))
+	for k, v := range existing {
+		merged[k] = v
+	}
+	for k, v := range desired {
+		merged[k] = v
+	}
+
+	return merged
+}
+
+// pruneExtendedProperties removes from event the extended property keys that
+// state tracked but plan no longer declares. Keys neither of them mention
+// belong to other applications and are left alone.
+func pruneExtendedProperties(ctx context.Context, state, plan *eventResourceModel, event *calendar.Event) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	if event.ExtendedProperties == nil || state.ExtendedProperties.IsNull() || state.ExtendedProperties.IsUnknown() {
+		return diags
+	}
+
+	var prior, desired extendedPropertiesModel
+	diags = append(diags, state.ExtendedProperties.As(ctx, &prior, basetypes.ObjectAsOptions{})...)
+	if !plan.ExtendedProperties.IsNull() && !plan.ExtendedProperties.IsUnknown() {
+		diags = append(diags, plan.ExtendedProperties.As(ctx, &desired, basetypes.ObjectAsOptions{})...)
+	}
+	if diags.HasError() {
+		return diags
+	}
+
+	prune := func(live map[string]string, prior, desired types.Map) {
+		for k := range prior.Elements() {
+			if _, ok := desired.Elements()[k]; !ok {
+				delete(live, k)
+			}
+		}
+	}
+	prune(event.ExtendedProperties.Private, prior.Private, desired.Private)
+	prune(event.ExtendedProperties.Shared, prior.Shared, desired.Shared)
+
+	return diags
+}
+
+// pruneRooms removes from event the rooms that state booked but plan no
+// longer declares. Without an attendee block buildEvent keeps the event's
+// attendees as they are, so they'd otherwise stay booked.
+func pruneRooms(ctx context.Context, state, plan *eventResourceModel, event *calendar.Event) diag.Diagnostics {
+	prior, diags := roomSetEmails(ctx, state.Rooms)
+	desired, d := roomSetEmails(ctx, plan.Rooms)
+	diags = append(diags, d...)
+	if diags.HasError() {
+		return diags
+	}
+
+	event.Attendees = slices.DeleteFunc(event.Attendees, func(att *calendar.EventAttendee) bool {
+		same := func(email string) bool { return strings.EqualFold(email, att.Email) }
+		return att.Resource && slices.ContainsFunc(prior, same) && !slices.ContainsFunc(desired, same)
+	})
+
+	return diags
+}
+
+// roomSetEmails returns the emails of the rooms in a room set.
+func roomSetEmails(ctx context.Context, rooms types.Set) ([]string, diag.Diagnostics) {
+	if rooms.IsNull() || rooms.IsUnknown() {
+		return nil, nil
+	}
+
+	var models []roomModel
+	diags := rooms.ElementsAs(ctx, &models, false)
+	emails := make([]string, len(models))
+	for i, room := range models {
+		emails[i] = room.Email.ValueString()
+	}
+
+	return emails, diags
+}
+
 // readEvent updates the Terraform model from a calendar.Event.
 func (r *eventResource) readEvent(ctx context.Context, model *eventResourceModel, event *calendar.Event) {
 	model.Summary = types.StringValue(event.Summary)
@@ -821,18 +2763,55 @@ func (r *eventResource) readEvent(ctx context.Context, model *eventResourceModel
 		model.Location = types.StringNull()
 	}
 
-	if event.Description != "" {
-		model.Description = types.StringValue(event.Description)
-	} else {
+	// Keep the configured description as long as it still says the same as
+	// Google's rewritten copy of it
+	switch {
+	case event.Description == "":
 		model.Description = types.StringNull()
+	case model.Description.IsNull() || model.Description.IsUnknown() ||
+		!descriptionMatches(model.Description.ValueString(), event.Description, model.DescriptionFormat.ValueString()):
+		model.Description = types.StringValue(event.Description)
 	}
 
-	if event.Start != nil {
-		model.Start = types.StringValue(event.Start.DateTime)
-		model.Timezone = types.StringValue(event.Start.TimeZone)
+	// Leave the EXDATEs generated for skip blocks and holidays out of the
+	// recurrence read back, but not those configured, so it still compares
+	// equal to the configured one
+	configured := *event
+	if event.Start != nil && event.Start.TimeZone != "" {
+		loc, err := time.LoadLocation(event.Start.TimeZone)
+		if err != nil {
+			loc = time.UTC
+		}
+		windows, _ := modelSkipWindows(ctx, model, event.Start.TimeZone)
+		lines, _ := recurrenceLines(ctx, model)
+		configured.Recurrence = withoutExdates(event.Recurrence, lines, loc, func(t time.Time) bool {
+			for _, w := range windows {
+				if w.contains(t) {
+					return true
+				}
+			}
+			return false
+		})
 	}
-	if event.End != nil {
-		model.End = types.StringValue(event.End.DateTime)
+
+	// Likewise the UNTIL ends_on adds, as long as it's still where ends_on
+	// puts it
+	if !model.EndsOn.IsNull() && !model.EndsOn.IsUnknown() {
+		if lines, diags := recurrenceLines(ctx, model); !diags.HasError() && lines != nil {
+			boundary, err := endsOnBoundary(lines, event.Start, model.EndsOn.ValueString())
+			if err == nil && boundary != nil {
+				want, wantErr := canonicalRecurrence(capRecurrenceUntil(lines, *boundary))
+				live, liveErr := canonicalRecurrence(configured.Recurrence)
+				if wantErr == nil && liveErr == nil && slices.Equal(want, live) {
+					configured.Recurrence = lines
+				}
+			}
+		}
+	}
+
+	readEventTime(&configured, &model.Start, &model.End, &model.Timezone, &model.Recurrence)
+	if !model.Schedule.IsNull() && !model.Schedule.IsUnknown() {
+		readSchedule(ctx, model, configured.Recurrence)
 	}
 
 	if event.GuestsCanInviteOthers != nil {
@@ -846,53 +2825,29 @@ func (r *eventResource) readEvent(ctx context.Context, model *eventResourceModel
 	model.ShowAsAvailable = types.BoolValue(transparencyToBool(event.Transparency))
 	model.Visibility = types.StringValue(event.Visibility)
 
-	// Set recurrence
-	if len(event.Recurrence) > 0 {
-		recurrenceList := make([]attr.Value, len(event.Recurrence))
-		for i, r := range event.Recurrence {
-			recurrenceList[i] = types.StringValue(r)
-		}
-		model.Recurrence, _ = types.ListValue(types.StringType, recurrenceList)
-	} else {
-		model.Recurrence = types.ListNull(types.StringType)
-	}
-
 	// Set conference data
-	if event.ConferenceData != nil && len(event.ConferenceData.EntryPoints) > 0 {
-		conferenceMap := map[string]attr.Value{
-			"google_meet_id": types.StringValue(path.Base(event.ConferenceData.EntryPoints[0].Uri)),
+	createGoogleMeet := types.BoolNull()
+	if !model.Conference.IsNull() && !model.Conference.IsUnknown() {
+		if v, ok := model.Conference.Attributes()["create_google_meet"].(types.Bool); ok {
+			createGoogleMeet = v
 		}
-		model.Conference, _ = types.MapValue(types.StringType, conferenceMap)
+	}
+	if event.ConferenceData != nil {
+		model.Conference = conferenceValue(event.ConferenceData, createGoogleMeet)
 	} else {
-		model.Conference = types.MapNull(types.StringType)
+		model.Conference = types.ObjectNull(conferenceAttrTypes)
 	}
 
-	// Set attendees
-	if len(event.Attendees) > 0 {
-		attendeeObjectType := types.ObjectType{
-			AttrTypes: map[string]attr.Type{
-				"email":    types.StringType,
-				"optional": types.BoolType,
-			},
-		}
-		attendeeList := make([]attr.Value, len(event.Attendees))
-		for i, att := range event.Attendees {
-			attendeeList[i], _ = types.ObjectValue(
-				attendeeObjectType.AttrTypes,
-				map[string]attr.Value{
-					"email":    types.StringValue(att.Email),
-					"optional": types.BoolValue(att.Optional),
-				},
-			)
-		}
-		model.Attendees, _ = types.SetValue(attendeeObjectType, attendeeList)
+	// Set the projected occurrences
+	readOccurrences(model, event)
+
+	// Set attendees and rooms - unless the API truncated the list, in which
+	// case the model's own copy is the best information there is, once
+	// anything it doesn't know yet is filled in
+	if event.AttendeesOmitted {
+		resolveAttendees(ctx, model, event.Attendees)
 	} else {
-		model.Attendees = types.SetNull(types.ObjectType{
-			AttrTypes: map[string]attr.Type{
-				"email":    types.StringType,
-				"optional": types.BoolType,
-			},
-		})
+		readAttendees(ctx, r.config, model, event.Attendees)
 	}
 
 	// Set attachments
@@ -934,8 +2889,257 @@ func (r *eventResource) readEvent(ctx context.Context, model *eventResourceModel
 		})
 	}
 
+	// Set extended properties - only the keys the model already tracks, since
+	// other applications are free to add their own
+	if !model.ExtendedProperties.IsNull() && !model.ExtendedProperties.IsUnknown() {
+		var tracked extendedPropertiesModel
+		model.ExtendedProperties.As(ctx, &tracked, basetypes.ObjectAsOptions{})
+
+		var private, shared map[string]string
+		if event.ExtendedProperties != nil {
+			private = event.ExtendedProperties.Private
+			shared = event.ExtendedProperties.Shared
+		}
+
+		model.ExtendedProperties, _ = types.ObjectValue(
+			extendedPropertiesAttrTypes,
+			map[string]attr.Value{
+				"private": trackedProperties(tracked.Private, private),
+				"shared":  trackedProperties(tracked.Shared, shared),
+			},
+		)
+	}
+
+	// Set source
+	if event.Source != nil {
+		model.Source, _ = types.ObjectValue(
+			sourceAttrTypes,
+			map[string]attr.Value{
+				"title": optionalString(event.Source.Title),
+				"url":   types.StringValue(event.Source.Url),
+			},
+		)
+	} else {
+		model.Source = types.ObjectNull(sourceAttrTypes)
+	}
+
+	// Set Google Meet details
+	model.GoogleMeetID = types.StringNull()
+	model.GoogleMeetURI = types.StringNull()
+	if event.ConferenceData != nil && event.ConferenceData.ConferenceSolution != nil &&
+		event.ConferenceData.ConferenceSolution.Key != nil &&
+		event.ConferenceData.ConferenceSolution.Key.Type == "hangoutsMeet" {
+		for _, ep := range event.ConferenceData.EntryPoints {
+			if ep.EntryPointType == "video" {
+				model.GoogleMeetID = types.StringValue(path.Base(ep.Uri))
+				model.GoogleMeetURI = types.StringValue(ep.Uri)
+				break
+			}
+		}
+	}
+
 	// Set computed fields
 	model.HTMLLink = types.StringValue(event.HtmlLink)
+	model.ICalUID = optionalString(event.ICalUID)
+	model.Etag = optionalString(event.Etag)
+	model.Created = optionalString(event.Created)
+	model.Updated = optionalString(event.Updated)
+	model.OrganizerEmail = types.StringNull()
+	if event.Organizer != nil {
+		model.OrganizerEmail = optionalString(event.Organizer.Email)
+	}
+	model.CreatorEmail = types.StringNull()
+	if event.Creator != nil {
+		model.CreatorEmail = optionalString(event.Creator.Email)
+	}
+	model.Status = optionalString(event.Status)
+	model.HangoutLink = optionalString(event.HangoutLink)
+	model.RecurringEventID = optionalString(event.RecurringEventId)
+}
+
+// trackedProperties returns the live values of the keys in tracked, omitting
+// any that are no longer on the event. A null tracked map stays null.
+func trackedProperties(tracked types.Map, live map[string]string) types.Map {
+	if tracked.IsNull() || tracked.IsUnknown() {
+		return types.MapNull(types.StringType)
+	}
+
+	values := map[string]attr.Value{}
+	for k := range tracked.Elements() {
+		if v, ok := live[k]; ok {
+			values[k] = types.StringValue(v)
+		}
+	}
+
+	m, _ := types.MapValue(types.StringType, values)
+	return m
+}
+
+// readAttendees updates the model's attendee and room sets from the event's
+// attendee list. Resource attendees the model tracks as rooms go into the
+// room set; everyone else, including untracked resources, goes into the
+// attendee set.
+func readAttendees(ctx context.Context, config *Config, model *eventResourceModel, apiAttendees []*calendar.EventAttendee) {
+	// Split rooms the model tracks out from the rest of the attendees
+	roomEmails := map[string]bool{}
+	if !model.Rooms.IsNull() && !model.Rooms.IsUnknown() {
+		var rooms []roomModel
+		model.Rooms.ElementsAs(ctx, &rooms, false)
+		for _, room := range rooms {
+			roomEmails[strings.ToLower(room.Email.ValueString())] = true
+		}
+	}
+	var attendees, rooms []*calendar.EventAttendee
+	for _, att := range apiAttendees {
+		if att.Resource && roomEmails[strings.ToLower(att.Email)] {
+			rooms = append(rooms, att)
+		} else {
+			attendees = append(attendees, att)
+		}
+	}
+
+	// Set rooms
+	roomObjectType := types.ObjectType{AttrTypes: roomAttrTypes}
+	if len(roomEmails) > 0 {
+		roomList := make([]attr.Value, len(rooms))
+		for i, room := range rooms {
+			roomList[i], _ = types.ObjectValue(
+				roomAttrTypes,
+				map[string]attr.Value{
+					"email":           newEmailValue(room.Email),
+					"response_status": types.StringValue(room.ResponseStatus),
+				},
+			)
+		}
+		model.Rooms, _ = types.SetValue(roomObjectType, roomList)
+	} else {
+		model.Rooms = types.SetNull(roomObjectType)
+	}
+
+	// Keep the configured address of attendees the API reports under a
+	// different one - their primary address rather than an alias
+	var configured []string
+	if !model.Attendees.IsNull() && !model.Attendees.IsUnknown() {
+		var prior []attendeeModel
+		model.Attendees.ElementsAs(ctx, &prior, false)
+		for _, att := range prior {
+			configured = append(configured, att.Email.ValueString())
+		}
+	}
+
+	// Set attendees
+	attendeeObjectType := types.ObjectType{AttrTypes: attendeeAttrTypes}
+	if len(attendees) > 0 {
+		attendeeList := make([]attr.Value, len(attendees))
+		for i, att := range attendees {
+			email := att.Email
+			for _, c := range configured {
+				if config.sameAddress(ctx, c, email) {
+					email = c
+					break
+				}
+			}
+
+			attendeeList[i], _ = types.ObjectValue(
+				attendeeAttrTypes,
+				map[string]attr.Value{
+					"email":             newEmailValue(email),
+					"optional":          types.BoolValue(att.Optional),
+					"display_name":      optionalString(att.DisplayName),
+					"comment":           optionalString(att.Comment),
+					"additional_guests": types.Int64Value(att.AdditionalGuests),
+					"response_status":   types.StringValue(att.ResponseStatus),
+					"organizer":         types.BoolValue(att.Organizer),
+					"self":              types.BoolValue(att.Self),
+					"resource":          types.BoolValue(att.Resource),
+				},
+			)
+		}
+		model.Attendees, _ = types.SetValue(attendeeObjectType, attendeeList)
+	} else {
+		model.Attendees = types.SetNull(attendeeObjectType)
+	}
+}
+
+// resolveAttendees fills in the computed fields the model's attendees and
+// rooms don't know yet - as after a create or update whose response omitted
+// some attendees - from the first matching entry in known, or otherwise the
+// API's defaults for a guest who hasn't responded.
+func resolveAttendees(ctx context.Context, model *eventResourceModel, known []*calendar.EventAttendee) {
+	find := func(email string) *calendar.EventAttendee {
+		for _, att := range known {
+			if strings.EqualFold(att.Email, email) {
+				return att
+			}
+		}
+		return &calendar.EventAttendee{ResponseStatus: "needsAction"}
+	}
+
+	if !model.Attendees.IsNull() && !model.Attendees.IsUnknown() {
+		var attendees []attendeeModel
+		model.Attendees.ElementsAs(ctx, &attendees, false)
+		for i, att := range attendees {
+			match := find(att.Email.ValueString())
+			if att.ResponseStatus.IsUnknown() {
+				attendees[i].ResponseStatus = types.StringValue(match.ResponseStatus)
+			}
+			if att.Organizer.IsUnknown() {
+				attendees[i].Organizer = types.BoolValue(match.Organizer)
+			}
+			if att.Self.IsUnknown() {
+				attendees[i].Self = types.BoolValue(match.Self)
+			}
+			if att.Resource.IsUnknown() {
+				attendees[i].Resource = types.BoolValue(match.Resource)
+			}
+		}
+		model.Attendees, _ = types.SetValueFrom(ctx, types.ObjectType{AttrTypes: attendeeAttrTypes}, attendees)
+	}
+
+	if !model.Rooms.IsNull() && !model.Rooms.IsUnknown() {
+		var rooms []roomModel
+		model.Rooms.ElementsAs(ctx, &rooms, false)
+		for i, room := range rooms {
+			if room.ResponseStatus.IsUnknown() {
+				rooms[i].ResponseStatus = types.StringValue(find(room.Email.ValueString()).ResponseStatus)
+			}
+		}
+		model.Rooms, _ = types.SetValueFrom(ctx, types.ObjectType{AttrTypes: roomAttrTypes}, rooms)
+	}
+}
+
+// modelAttendees returns the model's attendees and rooms, with whatever of
+// their computed fields it knows, as API attendees.
+func modelAttendees(ctx context.Context, model *eventResourceModel) []*calendar.EventAttendee {
+	var out []*calendar.EventAttendee
+
+	if !model.Attendees.IsNull() && !model.Attendees.IsUnknown() {
+		var attendees []attendeeModel
+		model.Attendees.ElementsAs(ctx, &attendees, false)
+		for _, att := range attendees {
+			out = append(out, &calendar.EventAttendee{
+				Email:          att.Email.ValueString(),
+				ResponseStatus: att.ResponseStatus.ValueString(),
+				Organizer:      att.Organizer.ValueBool(),
+				Self:           att.Self.ValueBool(),
+				Resource:       att.Resource.ValueBool(),
+			})
+		}
+	}
+
+	if !model.Rooms.IsNull() && !model.Rooms.IsUnknown() {
+		var rooms []roomModel
+		model.Rooms.ElementsAs(ctx, &rooms, false)
+		for _, room := range rooms {
+			out = append(out, &calendar.EventAttendee{
+				Email:          room.Email.ValueString(),
+				ResponseStatus: room.ResponseStatus.ValueString(),
+				Resource:       true,
+			})
+		}
+	}
+
+	return out
 }
 
 // boolToTransparency converts a boolean representing "show as available" to the
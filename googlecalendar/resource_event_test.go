@@ -1,10 +1,27 @@
 package googlecalendar
 
 import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"reflect"
+	"slices"
+	"strings"
 	"testing"
 	"time"
 
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	fwpath "github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
 	"google.golang.org/api/calendar/v3"
+	"google.golang.org/api/option"
 )
 
 func TestNextOccurrenceBoundary(t *testing.T) {
@@ -88,3 +105,1214 @@ func TestCapRecurrenceUntil(t *testing.T) {
 		})
 	}
 }
+
+func TestMergeProperties(t *testing.T) {
+	existing := map[string]string{"team": "infra", "bot": "keep-me"}
+	desired := map[string]string{"team": "platform", "cost_center": "cc-42"}
+
+	got := mergeProperties(existing, desired)
+
+	want := map[string]string{"team": "platform", "bot": "keep-me", "cost_center": "cc-42"}
+	if len(got) != len(want) {
+		t.Fatalf("got %v, want %v", got, want)
+	}
+	for k, v := range want {
+		if got[k] != v {
+			t.Errorf("key %q: got %q, want %q", k, got[k], v)
+		}
+	}
+}
+
+func TestRoomResponses(t *testing.T) {
+	event := &calendar.Event{
+		Attendees: []*calendar.EventAttendee{
+			{Email: "me@domain.com", ResponseStatus: "accepted"},
+			{Email: "Room-A@resource.calendar.google.com", Resource: true, ResponseStatus: "accepted"},
+			{Email: "room-b@resource.calendar.google.com", Resource: true, ResponseStatus: "declined"},
+			{Email: "room-c@resource.calendar.google.com", Resource: true, ResponseStatus: "needsAction"},
+		},
+	}
+	rooms := []string{
+		"room-a@resource.calendar.google.com",
+		"room-b@resource.calendar.google.com",
+		"room-c@resource.calendar.google.com",
+		"room-d@resource.calendar.google.com",
+	}
+
+	pending, declined := roomResponses(event, rooms)
+
+	if len(declined) != 1 || declined[0] != "room-b@resource.calendar.google.com" {
+		t.Errorf("declined: got %v", declined)
+	}
+	// room-d isn't on the event yet, which counts as not having responded.
+	if len(pending) != 2 || pending[0] != "room-c@resource.calendar.google.com" || pending[1] != "room-d@resource.calendar.google.com" {
+		t.Errorf("pending: got %v", pending)
+	}
+}
+
+func TestSendUpdates(t *testing.T) {
+	cases := []struct {
+		name              string
+		sendUpdates       types.String
+		sendNotifications types.Bool
+		want              string
+	}{
+		{"both set", types.StringValue("externalOnly"), types.BoolValue(false), "externalOnly"},
+		{"neither set", types.StringNull(), types.BoolNull(), "all"},
+		{"deprecated true", types.StringNull(), types.BoolValue(true), "all"},
+		{"deprecated false", types.StringNull(), types.BoolValue(false), "none"},
+		{"unknown send_updates", types.StringUnknown(), types.BoolValue(false), "none"},
+	}
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			model := &eventResourceModel{SendUpdates: tc.sendUpdates, SendNotifications: tc.sendNotifications}
+			if got := sendUpdates(model); got != tc.want {
+				t.Errorf("got %q, want %q", got, tc.want)
+			}
+		})
+	}
+}
+
+func TestPruneRooms(t *testing.T) {
+	ctx := context.Background()
+	rooms := func(emails ...string) types.Set {
+		elems := make([]attr.Value, len(emails))
+		for i, email := range emails {
+			elems[i] = types.ObjectValueMust(roomAttrTypes, map[string]attr.Value{
+				"email":           newEmailValue(email),
+				"response_status": types.StringValue("accepted"),
+			})
+		}
+		return types.SetValueMust(types.ObjectType{AttrTypes: roomAttrTypes}, elems)
+	}
+
+	state := &eventResourceModel{Rooms: rooms("room-a@resource.calendar.google.com", "room-b@resource.calendar.google.com")}
+	plan := &eventResourceModel{Rooms: rooms("room-a@resource.calendar.google.com")}
+	event := &calendar.Event{
+		Attendees: []*calendar.EventAttendee{
+			{Email: "alice@example.com"},
+			{Email: "room-a@resource.calendar.google.com", Resource: true},
+			{Email: "Room-B@resource.calendar.google.com", Resource: true},
+			// Booked outside Terraform, so left alone.
+			{Email: "room-c@resource.calendar.google.com", Resource: true},
+		},
+	}
+
+	if diags := pruneRooms(ctx, state, plan, event); diags.HasError() {
+		t.Fatalf("unexpected diagnostics: %v", diags)
+	}
+
+	var got []string
+	for _, att := range event.Attendees {
+		got = append(got, att.Email)
+	}
+	want := []string{"alice@example.com", "room-a@resource.calendar.google.com", "room-c@resource.calendar.google.com"}
+	if !slices.Equal(got, want) {
+		t.Errorf("got %v, want %v", got, want)
+	}
+}
+
+func TestResolveAttendees(t *testing.T) {
+	ctx := context.Background()
+	attendee := func(email string, status attr.Value) attr.Value {
+		return types.ObjectValueMust(attendeeAttrTypes, map[string]attr.Value{
+			"email":             newEmailValue(email),
+			"optional":          types.BoolValue(false),
+			"display_name":      types.StringNull(),
+			"comment":           types.StringNull(),
+			"additional_guests": types.Int64Value(0),
+			"response_status":   status,
+			"organizer":         types.BoolUnknown(),
+			"self":              types.BoolUnknown(),
+			"resource":          types.BoolUnknown(),
+		})
+	}
+	model := eventResourceModel{
+		Attendees: types.SetValueMust(types.ObjectType{AttrTypes: attendeeAttrTypes}, []attr.Value{
+			attendee("me@example.com", types.StringUnknown()),
+			attendee("alice@example.com", types.StringUnknown()),
+			attendee("bob@example.com", types.StringUnknown()),
+		}),
+		Rooms: types.SetValueMust(types.ObjectType{AttrTypes: roomAttrTypes}, []attr.Value{
+			types.ObjectValueMust(roomAttrTypes, map[string]attr.Value{
+				"email":           newEmailValue("room-a@resource.calendar.google.com"),
+				"response_status": types.StringUnknown(),
+			}),
+		}),
+	}
+
+	// The truncated response only has the caller; alice comes from state.
+	resolveAttendees(ctx, &model, []*calendar.EventAttendee{
+		{Email: "me@example.com", ResponseStatus: "accepted", Organizer: true, Self: true},
+		{Email: "Alice@example.com", ResponseStatus: "declined"},
+	})
+
+	var attendees []attendeeModel
+	if diags := model.Attendees.ElementsAs(ctx, &attendees, false); diags.HasError() {
+		t.Fatalf("unexpected diagnostics: %v", diags)
+	}
+	want := map[string]attendeeModel{
+		"me@example.com":    {ResponseStatus: types.StringValue("accepted"), Organizer: types.BoolValue(true), Self: types.BoolValue(true), Resource: types.BoolValue(false)},
+		"alice@example.com": {ResponseStatus: types.StringValue("declined"), Organizer: types.BoolValue(false), Self: types.BoolValue(false), Resource: types.BoolValue(false)},
+		"bob@example.com":   {ResponseStatus: types.StringValue("needsAction"), Organizer: types.BoolValue(false), Self: types.BoolValue(false), Resource: types.BoolValue(false)},
+	}
+	for _, a := range attendees {
+		w := want[a.Email.ValueString()]
+		if !a.ResponseStatus.Equal(w.ResponseStatus) || !a.Organizer.Equal(w.Organizer) || !a.Self.Equal(w.Self) || !a.Resource.Equal(w.Resource) {
+			t.Errorf("%s: got %+v", a.Email.ValueString(), a)
+		}
+	}
+
+	var rooms []roomModel
+	if diags := model.Rooms.ElementsAs(ctx, &rooms, false); diags.HasError() {
+		t.Fatalf("unexpected diagnostics: %v", diags)
+	}
+	if len(rooms) != 1 || rooms[0].ResponseStatus.ValueString() != "needsAction" {
+		t.Errorf("rooms: got %+v", rooms)
+	}
+}
+
+func TestConferenceChanged(t *testing.T) {
+	conference := func(conferenceID, solutionType attr.Value) types.Object {
+		return types.ObjectValueMust(conferenceAttrTypes, map[string]attr.Value{
+			"solution_type":      solutionType,
+			"solution_name":      types.StringNull(),
+			"conference_id":      conferenceID,
+			"create_google_meet": types.BoolNull(),
+			"notes":              types.StringNull(),
+			"entry_points":       types.ListNull(types.ObjectType{AttrTypes: entryPointAttrTypes}),
+		})
+	}
+	state := conference(types.StringValue("aaa-bbbb-ccc"), types.StringValue("hangoutsMeet"))
+
+	cases := []struct {
+		name   string
+		config types.Object
+		want   bool
+	}{
+		{"unchanged", conference(types.StringValue("aaa-bbbb-ccc"), types.StringNull()), false},
+		{"new conference", conference(types.StringValue("ddd-eeee-fff"), types.StringNull()), true},
+		{"removed", types.ObjectNull(conferenceAttrTypes), true},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			if got := conferenceChanged(tc.config, state); got != tc.want {
+				t.Errorf("got %v, want %v", got, tc.want)
+			}
+		})
+	}
+
+	if !conferenceChanged(state, types.ObjectNull(conferenceAttrTypes)) {
+		t.Error("adding a conference: got false, want true")
+	}
+}
+
+// eventSchema returns the event resource schema.
+func eventSchema(t *testing.T) schema.Schema {
+	t.Helper()
+	var resp resource.SchemaResponse
+	(&eventResource{}).Schema(context.Background(), resource.SchemaRequest{}, &resp)
+	if resp.Diagnostics.HasError() {
+		t.Fatalf("unexpected diagnostics: %v", resp.Diagnostics)
+	}
+	return resp.Schema
+}
+
+// objectValue returns an object of type typ with the given attributes, and
+// every other attribute null.
+func objectValue(typ tftypes.Type, attrs map[string]tftypes.Value) tftypes.Value {
+	object := typ.(tftypes.Object)
+	values := map[string]tftypes.Value{}
+	for name, attrType := range object.AttributeTypes {
+		if v, ok := attrs[name]; ok {
+			values[name] = v
+		} else {
+			values[name] = tftypes.NewValue(attrType, nil)
+		}
+	}
+	return tftypes.NewValue(object, values)
+}
+
+// eventModel returns an event model with the given attributes, and every
+// other attribute null.
+func eventModel(t *testing.T, attrs map[string]tftypes.Value) eventResourceModel {
+	t.Helper()
+	ctx := context.Background()
+	s := eventSchema(t)
+	state := tfsdk.State{Schema: s, Raw: objectValue(s.Type().TerraformType(ctx), attrs)}
+	var model eventResourceModel
+	if diags := state.Get(ctx, &model); diags.HasError() {
+		t.Fatalf("unexpected diagnostics: %v", diags)
+	}
+	return model
+}
+
+// modifyPlan runs the event resource's ModifyPlan against raw config, state
+// and plan values, returning the modified plan.
+func modifyPlan(t *testing.T, r *eventResource, config, state, plan tftypes.Value) tfsdk.Plan {
+	t.Helper()
+	ctx := context.Background()
+	s := eventSchema(t)
+	req := resource.ModifyPlanRequest{
+		Config: tfsdk.Config{Schema: s, Raw: config},
+		State:  tfsdk.State{Schema: s, Raw: state},
+		Plan:   tfsdk.Plan{Schema: s, Raw: plan},
+	}
+	resp := resource.ModifyPlanResponse{Plan: req.Plan}
+	r.ModifyPlan(ctx, req, &resp)
+	if resp.Diagnostics.HasError() {
+		t.Fatalf("unexpected diagnostics: %v", resp.Diagnostics)
+	}
+	return resp.Plan
+}
+
+func TestModifyPlan_ConferenceChanged(t *testing.T) {
+	ctx := context.Background()
+	typ := eventSchema(t).Type().TerraformType(ctx).(tftypes.Object)
+	conferenceType := typ.AttributeTypes["conference"]
+	event := func(conference map[string]tftypes.Value, meetID tftypes.Value) tftypes.Value {
+		return objectValue(typ, map[string]tftypes.Value{
+			"id":             tftypes.NewValue(tftypes.String, "abc"),
+			"summary":        tftypes.NewValue(tftypes.String, "Standup"),
+			"conference":     objectValue(conferenceType, conference),
+			"google_meet_id": meetID,
+		})
+	}
+	state := event(map[string]tftypes.Value{
+		"solution_type": tftypes.NewValue(tftypes.String, "hangoutsMeet"),
+		"solution_name": tftypes.NewValue(tftypes.String, "Google Meet"),
+		"conference_id": tftypes.NewValue(tftypes.String, "aaa-bbbb-ccc"),
+	}, tftypes.NewValue(tftypes.String, "aaa-bbbb-ccc"))
+
+	// Switching to another existing conference, with the computed
+	// attributes kept from state by their plan modifiers
+	config := event(map[string]tftypes.Value{
+		"conference_id": tftypes.NewValue(tftypes.String, "ddd-eeee-fff"),
+	}, tftypes.NewValue(tftypes.String, nil))
+	plan := event(map[string]tftypes.Value{
+		"solution_type": tftypes.NewValue(tftypes.String, "hangoutsMeet"),
+		"solution_name": tftypes.NewValue(tftypes.String, "Google Meet"),
+		"conference_id": tftypes.NewValue(tftypes.String, "ddd-eeee-fff"),
+	}, tftypes.NewValue(tftypes.String, "aaa-bbbb-ccc"))
+
+	got := modifyPlan(t, &eventResource{}, config, state, plan)
+
+	for _, p := range []fwpath.Path{
+		fwpath.Root("google_meet_id"),
+		fwpath.Root("conference").AtName("solution_type"),
+		fwpath.Root("conference").AtName("solution_name"),
+	} {
+		var v types.String
+		got.GetAttribute(ctx, p, &v)
+		if !v.IsUnknown() {
+			t.Errorf("%s: got %s, want unknown", p, v)
+		}
+	}
+	var conferenceID types.String
+	got.GetAttribute(ctx, fwpath.Root("conference").AtName("conference_id"), &conferenceID)
+	if conferenceID.ValueString() != "ddd-eeee-fff" {
+		t.Errorf("conference_id: got %s, want the configured ddd-eeee-fff", conferenceID)
+	}
+
+	// Without a change, state is kept
+	got = modifyPlan(t, &eventResource{}, config, plan, plan)
+	var meetID types.String
+	got.GetAttribute(ctx, fwpath.Root("google_meet_id"), &meetID)
+	if meetID.ValueString() != "aaa-bbbb-ccc" {
+		t.Errorf("unchanged google_meet_id: got %s", meetID)
+	}
+}
+
+func TestModifyPlan_TimeZoneChanged(t *testing.T) {
+	ctx := context.Background()
+	typ := eventSchema(t).Type().TerraformType(ctx).(tftypes.Object)
+	event := func(start, end, timezone string) tftypes.Value {
+		return objectValue(typ, map[string]tftypes.Value{
+			"id":       tftypes.NewValue(tftypes.String, "abc"),
+			"summary":  tftypes.NewValue(tftypes.String, "Standup"),
+			"start":    tftypes.NewValue(tftypes.String, start),
+			"end":      tftypes.NewValue(tftypes.String, end),
+			"timezone": tftypes.NewValue(tftypes.String, timezone),
+		})
+	}
+	state := event("2026-01-05T09:00:00+01:00", "2026-01-05T09:30:00+01:00", "Europe/Paris")
+
+	// Semantic equality keeps the prior start and end in the plan, as
+	// they're the same wall-clock time
+	config := event("2026-01-05T09:00:00", "2026-01-05T09:30:00", "America/New_York")
+	plan := event("2026-01-05T09:00:00+01:00", "2026-01-05T09:30:00+01:00", "America/New_York")
+
+	got := modifyPlan(t, &eventResource{}, config, state, plan)
+
+	for name, want := range map[string]string{"start": "2026-01-05T09:00:00", "end": "2026-01-05T09:30:00"} {
+		var v dateTimeValue
+		got.GetAttribute(ctx, fwpath.Root(name), &v)
+		if v.ValueString() != want {
+			t.Errorf("%s: got %s, want the configured %s", name, v, want)
+		}
+	}
+
+	// Without a change of time zone, state is kept
+	config = event("2026-01-05T09:00:00", "2026-01-05T09:30:00", "Europe/Paris")
+	got = modifyPlan(t, &eventResource{}, config, state, state)
+	var start dateTimeValue
+	got.GetAttribute(ctx, fwpath.Root("start"), &start)
+	if start.ValueString() != "2026-01-05T09:00:00+01:00" {
+		t.Errorf("unchanged start: got %s", start)
+	}
+}
+
+// fakeCalendar returns a Config whose calendar client talks to handler.
+func fakeCalendar(t *testing.T, handler http.Handler) *Config {
+	t.Helper()
+	server := httptest.NewServer(handler)
+	t.Cleanup(server.Close)
+
+	svc, err := calendar.NewService(context.Background(),
+		option.WithEndpoint(server.URL+"/"),
+		option.WithHTTPClient(server.Client()),
+	)
+	if err != nil {
+		t.Fatalf("creating calendar service: %v", err)
+	}
+	return &Config{calendar: svc}
+}
+
+// serveJSON returns a handler that responds to every request with v.
+func serveJSON(t *testing.T, v any) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if err := json.NewEncoder(w).Encode(v); err != nil {
+			t.Errorf("encoding response: %v", err)
+		}
+	})
+}
+
+// holidayCalendar returns a Config whose calendars all list an all-day event
+// on each of days.
+func holidayCalendar(t *testing.T, days ...string) *Config {
+	var items []*calendar.Event
+	for _, day := range days {
+		next, _ := time.Parse(time.DateOnly, day)
+		items = append(items, &calendar.Event{
+			Summary: "Holiday",
+			Start:   &calendar.EventDateTime{Date: day},
+			End:     &calendar.EventDateTime{Date: next.AddDate(0, 0, 1).Format(time.DateOnly)},
+		})
+	}
+	return fakeCalendar(t, serveJSON(t, &calendar.Events{Items: items}))
+}
+
+func TestSkippedHolidays(t *testing.T) {
+	ctx := context.Background()
+	today := time.Now().UTC()
+	day := func(days int) string { return today.AddDate(0, 0, days).Format(time.DateOnly) }
+
+	// Weekdays only, so a holiday on a weekend isn't skipped
+	start := time.Date(today.Year()-1, 1, 1, 9, 0, 0, 0, time.UTC)
+	model := eventResourceModel{
+		Start:                   newDateTimeValue(start.Format("2006-01-02T15:04:05")),
+		Timezone:                types.StringValue("UTC"),
+		Recurrence:              newRecurrenceValue([]string{"RRULE:FREQ=WEEKLY;BYDAY=MO,TU,WE,TH,FR"}),
+		Schedule:                types.ObjectNull(scheduleAttrTypes),
+		SkipHolidaysCalendar:    types.StringValue("en.usa#holiday@group.v.calendar.google.com"),
+		SkipHolidaysHorizonDays: types.Int64Value(365),
+	}
+
+	var holidays, weekend []string
+	for d := 1; len(holidays) < 2 || len(weekend) < 1; d++ {
+		switch today.AddDate(0, 0, d).Weekday() {
+		case time.Saturday, time.Sunday:
+			if len(weekend) < 1 {
+				weekend = append(weekend, day(d))
+			}
+		default:
+			if len(holidays) < 2 {
+				holidays = append(holidays, day(d))
+			}
+		}
+	}
+	r := &eventResource{config: holidayCalendar(t, append(holidays, weekend...)...)}
+
+	// A past holiday stays skipped; an upcoming one that's no longer on
+	// the calendar doesn't
+	prior := types.ListValueMust(types.StringType, []attr.Value{
+		types.StringValue(day(-30)),
+		types.StringValue(day(200)),
+	})
+
+	got, diags := r.skippedHolidays(ctx, &model, prior)
+	if diags.HasError() {
+		t.Fatalf("unexpected diagnostics: %v", diags)
+	}
+	var days []string
+	got.ElementsAs(ctx, &days, false)
+	want := append([]string{day(-30)}, holidays...)
+	if !slices.Equal(days, want) {
+		t.Errorf("got %v, want %v", days, want)
+	}
+
+	// Without a recurrence there's nothing to skip
+	model.Recurrence = newRecurrenceNull()
+	if got, _ := r.skippedHolidays(ctx, &model, prior); !got.IsNull() {
+		t.Errorf("non-recurring: got %s, want null", got)
+	}
+}
+
+func TestModifyPlan_SkippedHolidays(t *testing.T) {
+	ctx := context.Background()
+	typ := eventSchema(t).Type().TerraformType(ctx).(tftypes.Object)
+	today := time.Now().UTC()
+	day := func(days int) string { return today.AddDate(0, 0, days).Format(time.DateOnly) }
+	str := func(v string) tftypes.Value { return tftypes.NewValue(tftypes.String, v) }
+	days := func(values ...string) tftypes.Value {
+		elements := make([]tftypes.Value, len(values))
+		for i, v := range values {
+			elements[i] = str(v)
+		}
+		return tftypes.NewValue(tftypes.List{ElementType: tftypes.String}, elements)
+	}
+	event := func(skipped tftypes.Value) tftypes.Value {
+		return objectValue(typ, map[string]tftypes.Value{
+			"id":                         str("abc"),
+			"start":                      str(today.AddDate(0, 0, -60).Format("2006-01-02") + "T09:00:00"),
+			"end":                        str(today.AddDate(0, 0, -60).Format("2006-01-02") + "T09:30:00"),
+			"timezone":                   str("UTC"),
+			"recurrence":                 days("RRULE:FREQ=DAILY"),
+			"skip_holidays_calendar":     str("en.usa#holiday@group.v.calendar.google.com"),
+			"skip_holidays_horizon_days": tftypes.NewValue(tftypes.Number, 365),
+			"skipped_holidays":           skipped,
+			"etag":                       str(`"3181161784712000"`),
+			"updated":                    str("2026-01-01T00:00:00Z"),
+		})
+	}
+
+	r := &eventResource{config: holidayCalendar(t, day(10))}
+
+	// A newly published holiday updates the event
+	state := event(days(day(-20)))
+	got := modifyPlan(t, r, state, state, state)
+
+	var skipped []string
+	got.GetAttribute(ctx, fwpath.Root("skipped_holidays"), &skipped)
+	if want := []string{day(-20), day(10)}; !slices.Equal(skipped, want) {
+		t.Errorf("skipped_holidays: got %v, want %v", skipped, want)
+	}
+	for _, name := range []string{"etag", "updated"} {
+		var v types.String
+		got.GetAttribute(ctx, fwpath.Root(name), &v)
+		if !v.IsUnknown() {
+			t.Errorf("%s: got %s, want unknown", name, v)
+		}
+	}
+
+	// Nothing new, so nothing changes
+	state = event(days(day(-20), day(10)))
+	got = modifyPlan(t, r, state, state, state)
+	var etag types.String
+	got.GetAttribute(ctx, fwpath.Root("etag"), &etag)
+	if etag.IsUnknown() {
+		t.Error("etag: got unknown with no new holidays")
+	}
+}
+
+func TestSkippedOutOfOffice(t *testing.T) {
+	ctx := context.Background()
+	start := time.Now().UTC().AddDate(0, 0, -10).Truncate(24 * time.Hour).Add(9 * time.Hour)
+	recurrence := []string{"RRULE:FREQ=DAILY"}
+	set, err := recurrenceSet(recurrence, &calendar.EventDateTime{DateTime: start.Format(time.RFC3339), TimeZone: "UTC"})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	occ := upcomingOccurrences(set, time.Now(), 5)
+
+	outOfOffice := func(at time.Time) *calendar.Events {
+		return &calendar.Events{Items: []*calendar.Event{{
+			EventType: "outOfOffice",
+			Start:     &calendar.EventDateTime{DateTime: at.Add(-time.Hour).Format(time.RFC3339)},
+			End:       &calendar.EventDateTime{DateTime: at.Add(2 * time.Hour).Format(time.RFC3339)},
+		}}}
+	}
+	period := func(from time.Time, d time.Duration) *calendar.TimePeriod {
+		return &calendar.TimePeriod{Start: from.Format(time.RFC3339), End: from.Add(d).Format(time.RFC3339)}
+	}
+	events := map[string]*calendar.Events{
+		"primary":          {},
+		"bob@example.com":  outOfOffice(occ[0]),
+		"eve@example.com":  outOfOffice(occ[0]),
+		"dave@example.com": outOfOffice(occ[4]),
+	}
+	r := &eventResource{config: fakeCalendar(t, http.HandlerFunc(func(w http.ResponseWriter, req *http.Request) {
+		if req.URL.Path == "/freeBusy" {
+			var query calendar.FreeBusyRequest
+			json.NewDecoder(req.Body).Decode(&query)
+			resp := &calendar.FreeBusyResponse{Calendars: map[string]calendar.FreeBusyCalendar{}}
+			for _, item := range query.Items {
+				var busy []*calendar.TimePeriod
+				if item.Id == "alice@example.com" {
+					// A day off, and the event itself
+					busy = []*calendar.TimePeriod{period(occ[1].Add(-time.Hour), 24*time.Hour), period(occ[3], 30*time.Minute)}
+				}
+				resp.Calendars[item.Id] = calendar.FreeBusyCalendar{Busy: busy}
+			}
+			json.NewEncoder(w).Encode(resp)
+			return
+		}
+
+		id := strings.TrimSuffix(strings.TrimPrefix(req.URL.Path, "/calendars/"), "/events")
+		if list, ok := events[id]; ok {
+			json.NewEncoder(w).Encode(list)
+			return
+		}
+		// Alice's events aren't visible, only her free/busy
+		w.WriteHeader(http.StatusNotFound)
+		w.Write([]byte(`{"error":{"code":404,"message":"Not Found"}}`))
+	}))}
+
+	attendee := func(email string, optional bool, status string) attendeeModel {
+		return attendeeModel{
+			Email:            newEmailValue(email),
+			Optional:         types.BoolValue(optional),
+			DisplayName:      types.StringNull(),
+			Comment:          types.StringNull(),
+			AdditionalGuests: types.Int64Value(0),
+			ResponseStatus:   types.StringValue(status),
+			Organizer:        types.BoolValue(false),
+			Self:             types.BoolValue(false),
+			Resource:         types.BoolValue(false),
+		}
+	}
+	attendees, diags := types.SetValueFrom(ctx, types.ObjectType{AttrTypes: attendeeAttrTypes}, []attendeeModel{
+		attendee("alice@example.com", false, "accepted"),
+		attendee("bob@example.com", true, "accepted"),
+		attendee("eve@example.com", false, "declined"),
+		attendee("dave@example.com", false, "needsAction"),
+	})
+	if diags.HasError() {
+		t.Fatalf("unexpected diagnostics: %v", diags)
+	}
+	model := eventResourceModel{
+		Start:                newDateTimeValue(start.Format("2006-01-02T15:04:05")),
+		End:                  newDateTimeValue(start.Add(30 * time.Minute).Format("2006-01-02T15:04:05")),
+		Timezone:             types.StringValue("UTC"),
+		Recurrence:           newRecurrenceValue(recurrence),
+		Schedule:             types.ObjectNull(scheduleAttrTypes),
+		Attendees:            attendees,
+		SkipOutOfOffice:      types.BoolValue(true),
+		OutOfOfficeLookahead: types.Int64Value(5),
+	}
+
+	got, diags := r.skippedOutOfOffice(ctx, &model, types.ListNull(types.StringType))
+	if diags.HasError() || diags.WarningsCount() > 0 {
+		t.Fatalf("unexpected diagnostics: %v", diags)
+	}
+	var skipped []string
+	got.ElementsAs(ctx, &skipped, false)
+	want := []string{occ[1].Format(time.RFC3339), occ[4].Format(time.RFC3339)}
+	if !slices.Equal(skipped, want) {
+		t.Errorf("got %v, want %v", skipped, want)
+	}
+}
+
+func TestPreviewLastOccurrence(t *testing.T) {
+	ctx := context.Background()
+	s := eventSchema(t)
+	typ := s.Type().TerraformType(ctx).(tftypes.Object)
+	str := func(v string) tftypes.Value { return tftypes.NewValue(tftypes.String, v) }
+	lines := func(values ...string) tftypes.Value {
+		elements := make([]tftypes.Value, len(values))
+		for i, v := range values {
+			elements[i] = str(v)
+		}
+		return tftypes.NewValue(tftypes.List{ElementType: tftypes.String}, elements)
+	}
+	skipType := typ.AttributeTypes["skip"].(tftypes.Set)
+	skip := func(date string) tftypes.Value {
+		return tftypes.NewValue(skipType, []tftypes.Value{objectValue(skipType.ElementType, map[string]tftypes.Value{"date": str(date)})})
+	}
+
+	cases := []struct {
+		name  string
+		attrs map[string]tftypes.Value
+		want  string
+	}{
+		{
+			name: "weekly",
+			attrs: map[string]tftypes.Value{
+				"recurrence": lines("RRULE:FREQ=WEEKLY;BYDAY=MO,TH"),
+				"ends_on":    str("2026-03-12"),
+			},
+			want: "2026-03-09T09:00:00-04:00",
+		},
+		{
+			// The last Monday before ends_on is skipped
+			name: "skipped last occurrence",
+			attrs: map[string]tftypes.Value{
+				"recurrence": lines("RRULE:FREQ=WEEKLY;BYDAY=MO"),
+				"ends_on":    str("2026-03-12"),
+				"skip":       skip("2026-03-09"),
+			},
+			want: "2026-03-02T09:00:00-05:00",
+		},
+		{
+			name: "over before ends_on",
+			attrs: map[string]tftypes.Value{
+				"recurrence": lines("RRULE:FREQ=DAILY;COUNT=3"),
+				"ends_on":    str("2026-06-01"),
+			},
+			want: "2026-01-07T09:00:00-05:00",
+		},
+		{
+			name: "schedule",
+			attrs: map[string]tftypes.Value{
+				"schedule": objectValue(typ.AttributeTypes["schedule"], map[string]tftypes.Value{
+					"frequency": str("monthly"),
+					"by_day":    lines("-1FR"),
+				}),
+				"ends_on": str("2026-04-24"),
+			},
+			want: "2026-03-27T09:00:00-04:00",
+		},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			attrs := map[string]tftypes.Value{
+				"summary":                    str("Standup"),
+				"start":                      str("2026-01-05T09:00:00"),
+				"end":                        str("2026-01-05T09:15:00"),
+				"timezone":                   str("America/New_York"),
+				"upcoming_occurrences_count": tftypes.NewValue(tftypes.Number, 5),
+			}
+			for k, v := range tc.attrs {
+				attrs[k] = v
+			}
+			plan := tfsdk.Plan{Schema: s, Raw: objectValue(typ, attrs)}
+			var model eventResourceModel
+			if diags := plan.Get(ctx, &model); diags.HasError() {
+				t.Fatalf("unexpected diagnostics: %v", diags)
+			}
+
+			preview, diags := previewLastOccurrence(ctx, &model)
+			if diags.HasError() {
+				t.Fatalf("unexpected diagnostics: %v", diags)
+			}
+
+			// What Create sends, and so what reading it back finds
+			r := &eventResource{}
+			event, diags := r.buildEvent(ctx, &model, &calendar.Event{})
+			if diags.HasError() {
+				t.Fatalf("unexpected diagnostics: %v", diags)
+			}
+			readOccurrences(&model, event)
+
+			if !preview.Equal(model.LastOccurrence) {
+				t.Errorf("previewed %s, but apply reads back %s", preview, model.LastOccurrence)
+			}
+			if preview.ValueString() != tc.want {
+				t.Errorf("got %s, want %s", preview, tc.want)
+			}
+		})
+	}
+}
+
+// fakePrivateState is an in-memory privateState.
+type fakePrivateState map[string][]byte
+
+func (p fakePrivateState) GetKey(_ context.Context, key string) ([]byte, diag.Diagnostics) {
+	return p[key], nil
+}
+
+func (p fakePrivateState) SetKey(_ context.Context, key string, value []byte) diag.Diagnostics {
+	if len(value) == 0 {
+		delete(p, key)
+		return nil
+	}
+	p[key] = value
+	return nil
+}
+
+func TestSetMeetRequestID(t *testing.T) {
+	ctx := context.Background()
+	meetRequest := func() *calendar.Event {
+		return &calendar.Event{ConferenceData: &calendar.ConferenceData{
+			CreateRequest: &calendar.CreateConferenceRequest{
+				ConferenceSolutionKey: &calendar.ConferenceSolutionKey{Type: "hangoutsMeet"},
+			},
+		}}
+	}
+
+	first, second, other := meetRequest(), meetRequest(), meetRequest()
+	private := fakePrivateState{}
+	for _, event := range []*calendar.Event{first, second} {
+		if diags := setMeetRequestID(ctx, private, event); diags.HasError() {
+			t.Fatalf("unexpected diagnostics: %v", diags)
+		}
+	}
+	if diags := setMeetRequestID(ctx, fakePrivateState{}, other); diags.HasError() {
+		t.Fatalf("unexpected diagnostics: %v", diags)
+	}
+
+	id := first.ConferenceData.CreateRequest.RequestId
+	if id == "" || second.ConferenceData.CreateRequest.RequestId != id {
+		t.Errorf("same resource: got %q and %q, want the same ID", id, second.ConferenceData.CreateRequest.RequestId)
+	}
+	if other.ConferenceData.CreateRequest.RequestId == id {
+		t.Errorf("another resource got the same ID %q", id)
+	}
+
+	// An event without a Meet request is left alone.
+	if diags := setMeetRequestID(ctx, fakePrivateState{}, &calendar.Event{}); diags.HasError() {
+		t.Fatalf("unexpected diagnostics: %v", diags)
+	}
+}
+
+func TestClearMeetRequestID(t *testing.T) {
+	ctx := context.Background()
+	meet := func(status string) *calendar.Event {
+		return &calendar.Event{ConferenceData: &calendar.ConferenceData{
+			CreateRequest: &calendar.CreateConferenceRequest{
+				RequestId: "kept",
+				Status:    &calendar.ConferenceRequestStatus{StatusCode: status},
+			},
+		}}
+	}
+
+	cases := []struct {
+		name  string
+		event *calendar.Event
+		kept  bool
+	}{
+		{"pending", meet("pending"), true},
+		{"created", meet("success"), false},
+		{"removed", &calendar.Event{}, false},
+	}
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			private := fakePrivateState{meetRequestIDKey: []byte(`"kept"`)}
+			if diags := clearMeetRequestID(ctx, private, tc.event); diags.HasError() {
+				t.Fatalf("unexpected diagnostics: %v", diags)
+			}
+			if _, ok := private[meetRequestIDKey]; ok != tc.kept {
+				t.Errorf("got kept %t, want %t", ok, tc.kept)
+			}
+		})
+	}
+}
+
+// meetCalendar is a fake calendar holding the single event "abc". Like the
+// API, it creates a Google Meet for a request ID it hasn't seen before and
+// ignores the request otherwise.
+type meetCalendar struct {
+	t     *testing.T
+	event *calendar.Event
+	seen  map[string]bool
+}
+
+func (c *meetCalendar) ServeHTTP(w http.ResponseWriter, req *http.Request) {
+	if req.Method == http.MethodPut {
+		var event calendar.Event
+		if err := json.NewDecoder(req.Body).Decode(&event); err != nil {
+			c.t.Errorf("decoding event: %v", err)
+		}
+		event.Id = "abc"
+		if cd := event.ConferenceData; cd != nil && cd.CreateRequest != nil {
+			switch {
+			case !c.seen[cd.CreateRequest.RequestId]:
+				c.seen[cd.CreateRequest.RequestId] = true
+				id := fmt.Sprintf("meet-%d", len(c.seen))
+				event.ConferenceData = &calendar.ConferenceData{
+					ConferenceId:       id,
+					ConferenceSolution: &calendar.ConferenceSolution{Key: &calendar.ConferenceSolutionKey{Type: "hangoutsMeet"}, Name: "Google Meet"},
+					CreateRequest: &calendar.CreateConferenceRequest{
+						RequestId: cd.CreateRequest.RequestId,
+						Status:    &calendar.ConferenceRequestStatus{StatusCode: "success"},
+					},
+					EntryPoints: []*calendar.EntryPoint{{EntryPointType: "video", Uri: "https://meet.google.com/" + id}},
+				}
+			case cd.ConferenceSolution == nil:
+				event.ConferenceData = nil
+			}
+		}
+		c.event = &event
+	}
+	json.NewEncoder(w).Encode(c.event)
+}
+
+// meetUpdater returns a function applying an update of the event in cal to
+// the given configuration, through ModifyPlan and Update, and returning the
+// new state. Private state carries over from one update to the next.
+func meetUpdater(t *testing.T, cal *meetCalendar) func(conference map[string]tftypes.Value) eventResourceModel {
+	ctx := context.Background()
+	s := eventSchema(t)
+	typ := s.Type().TerraformType(ctx).(tftypes.Object)
+	r := &eventResource{config: fakeCalendar(t, cal)}
+
+	// The framework's private state type is internal, so make one the
+	// way it would
+	private := (&resource.UpdateResponse{}).Private
+	p := reflect.ValueOf(&private).Elem()
+	p.Set(reflect.New(p.Type().Elem()))
+
+	state := tfsdk.State{Schema: s, Raw: objectValue(typ, map[string]tftypes.Value{
+		"id":       tftypes.NewValue(tftypes.String, "abc"),
+		"summary":  tftypes.NewValue(tftypes.String, "Standup"),
+		"start":    tftypes.NewValue(tftypes.String, "2026-01-05T09:00:00"),
+		"end":      tftypes.NewValue(tftypes.String, "2026-01-05T09:30:00"),
+		"timezone": tftypes.NewValue(tftypes.String, "UTC"),
+	})}
+
+	return func(conference map[string]tftypes.Value) eventResourceModel {
+		t.Helper()
+		attrs := map[string]tftypes.Value{
+			"summary":  tftypes.NewValue(tftypes.String, "Standup"),
+			"start":    tftypes.NewValue(tftypes.String, "2026-01-05T09:00:00"),
+			"end":      tftypes.NewValue(tftypes.String, "2026-01-05T09:30:00"),
+			"timezone": tftypes.NewValue(tftypes.String, "UTC"),
+		}
+		conferenceType := typ.AttributeTypes["conference"].(tftypes.Object)
+		if conference != nil {
+			attrs["conference"] = objectValue(conferenceType, conference)
+		}
+		config := objectValue(typ, attrs)
+
+		// Plan the conference's unset computed attributes the way their
+		// UseStateForUnknown plan modifiers would
+		attrs["id"] = tftypes.NewValue(tftypes.String, "abc")
+		if conference != nil {
+			var prior map[string]tftypes.Value
+			var priorConference tftypes.Value
+			state.Raw.As(&prior)
+			priorConference = prior["conference"]
+			priorAttrs := map[string]tftypes.Value{}
+			priorConference.As(&priorAttrs)
+			planned := map[string]tftypes.Value{}
+			for name, v := range conference {
+				planned[name] = v
+			}
+			for _, name := range []string{"solution_type", "solution_name", "conference_id", "entry_points"} {
+				if _, ok := planned[name]; ok {
+					continue
+				}
+				if priorConference.IsNull() {
+					planned[name] = tftypes.NewValue(conferenceType.AttributeTypes[name], tftypes.UnknownValue)
+				} else {
+					planned[name] = priorAttrs[name]
+				}
+			}
+			attrs["conference"] = objectValue(conferenceType, planned)
+		}
+		plan := modifyPlan(t, r, config, state.Raw, objectValue(typ, attrs))
+
+		req := resource.UpdateRequest{Config: tfsdk.Config{Schema: s, Raw: config}, Plan: plan, State: state, Private: private}
+		resp := &resource.UpdateResponse{State: tfsdk.State{Schema: s, Raw: plan.Raw}, Private: private}
+		r.Update(ctx, req, resp)
+		if resp.Diagnostics.HasError() {
+			t.Fatalf("unexpected diagnostics: %v", resp.Diagnostics)
+		}
+		state = resp.State
+
+		var model eventResourceModel
+		if diags := state.Get(ctx, &model); diags.HasError() {
+			t.Fatalf("unexpected diagnostics: %v", diags)
+		}
+		return model
+	}
+}
+
+func TestUpdate_MeetReadded(t *testing.T) {
+	cal := &meetCalendar{t: t, event: &calendar.Event{Id: "abc"}, seen: map[string]bool{}}
+	update := meetUpdater(t, cal)
+	meet := map[string]tftypes.Value{"create_google_meet": tftypes.NewValue(tftypes.Bool, true)}
+
+	first := update(meet)
+	if first.GoogleMeetID.ValueString() != "meet-1" {
+		t.Fatalf("adding a Meet: got google_meet_id %s, want meet-1", first.GoogleMeetID)
+	}
+	if kept := update(meet); kept.GoogleMeetID.ValueString() != "meet-1" {
+		t.Errorf("unchanged: got google_meet_id %s, want meet-1 kept", kept.GoogleMeetID)
+	}
+	if removed := update(nil); !removed.GoogleMeetID.IsNull() {
+		t.Errorf("removing the Meet: got google_meet_id %s, want null", removed.GoogleMeetID)
+	}
+	if readded := update(meet); readded.GoogleMeetID.ValueString() != "meet-2" {
+		t.Errorf("adding the Meet back: got google_meet_id %s, want a new meet-2", readded.GoogleMeetID)
+	}
+}
+
+func TestUpdate_SwitchToMeet(t *testing.T) {
+	cases := []struct {
+		name     string
+		existing *calendar.ConferenceData
+	}{
+		{
+			name: "existing Meet",
+			existing: &calendar.ConferenceData{
+				ConferenceId:       "aaa-bbbb-ccc",
+				ConferenceSolution: &calendar.ConferenceSolution{Key: &calendar.ConferenceSolutionKey{Type: "hangoutsMeet"}},
+				EntryPoints:        []*calendar.EntryPoint{{EntryPointType: "video", Uri: "https://meet.google.com/aaa-bbbb-ccc"}},
+			},
+		},
+		{
+			name: "add-on",
+			existing: &calendar.ConferenceData{
+				ConferenceId:       "123456789",
+				ConferenceSolution: &calendar.ConferenceSolution{Key: &calendar.ConferenceSolutionKey{Type: "addOn"}, Name: "Zoom"},
+				EntryPoints:        []*calendar.EntryPoint{{EntryPointType: "video", Uri: "https://zoom.us/j/123456789"}},
+			},
+		},
+	}
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			cal := &meetCalendar{t: t, event: &calendar.Event{Id: "abc"}, seen: map[string]bool{}}
+			update := meetUpdater(t, cal)
+
+			// Track the existing conference, then ask for a new Meet instead
+			cal.event.ConferenceData = tc.existing
+			update(map[string]tftypes.Value{"conference_id": tftypes.NewValue(tftypes.String, tc.existing.ConferenceId)})
+			got := update(map[string]tftypes.Value{"create_google_meet": tftypes.NewValue(tftypes.Bool, true)})
+
+			if got.GoogleMeetID.ValueString() != "meet-1" {
+				t.Errorf("got google_meet_id %s, want the new meet-1", got.GoogleMeetID)
+			}
+		})
+	}
+}
+
+func TestUpgradeEventStateV0(t *testing.T) {
+	cases := []struct {
+		name string
+		in   string
+		want string
+	}{
+		{
+			name: "google_meet_id",
+			in:   `{"id":"abc","conference":{"google_meet_id":"aaa-bbbb-ccc"}}`,
+			want: `{"conference":{"conference_id":"aaa-bbbb-ccc","solution_type":"hangoutsMeet"},"id":"abc"}`,
+		},
+		{
+			name: "create_google_meet",
+			in:   `{"id":"abc","conference":{"create_google_meet":"true"}}`,
+			want: `{"conference":{"create_google_meet":true,"solution_type":"hangoutsMeet"},"id":"abc"}`,
+		},
+		{
+			name: "no conference",
+			in:   `{"id":"abc","conference":null}`,
+			want: `{"conference":null,"id":"abc"}`,
+		},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			got, err := upgradeEventStateV0([]byte(tc.in))
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			if string(got) != tc.want {
+				t.Errorf("got %s, want %s", got, tc.want)
+			}
+		})
+	}
+}
+
+func TestUpcomingOccurrences(t *testing.T) {
+	start := &calendar.EventDateTime{DateTime: "2026-01-02T14:30:00-05:00"}
+	set, err := recurrenceSet([]string{"RRULE:FREQ=WEEKLY;COUNT=3"}, start)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	// From the start itself, which counts as upcoming
+	after := time.Date(2026, 1, 2, 19, 30, 0, 0, time.UTC)
+	upcoming := upcomingOccurrences(set, after, 5)
+	if len(upcoming) != 3 {
+		t.Fatalf("got %v, want the 3 occurrences of the series", upcoming)
+	}
+	if !upcoming[0].Equal(after) || !upcoming[2].Equal(after.AddDate(0, 0, 14)) {
+		t.Errorf("got %v", upcoming)
+	}
+
+	last := lastOccurrence(set)
+	if last == nil || !last.Equal(upcoming[2]) {
+		t.Errorf("last occurrence: got %v, want %v", last, upcoming[2])
+	}
+}
+
+func TestLastOccurrence_Unbounded(t *testing.T) {
+	start := &calendar.EventDateTime{DateTime: "2026-01-02T14:30:00-05:00"}
+	set, err := recurrenceSet([]string{"RRULE:FREQ=WEEKLY"}, start)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	if last := lastOccurrence(set); last != nil {
+		t.Errorf("expected no last occurrence for an unbounded series, got %v", last)
+	}
+}
+
+func TestEndsOnBoundary(t *testing.T) {
+	// Mondays at 10:00 New York time; the 2nd of March 2026 is a Monday.
+	start := &calendar.EventDateTime{DateTime: "2026-01-05T10:00:00", TimeZone: "America/New_York"}
+	recurrence := []string{"RRULE:FREQ=WEEKLY;COUNT=52"}
+
+	boundary, err := endsOnBoundary(recurrence, start, "2026-03-02")
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if want := time.Date(2026, 3, 2, 14, 59, 59, 0, time.UTC); boundary == nil || !boundary.Equal(want) {
+		t.Fatalf("got %v, want %v", boundary, want)
+	}
+
+	set, err := recurrenceSet(capRecurrenceUntil(recurrence, *boundary), start)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if last := lastOccurrence(set); last == nil || last.Format(time.RFC3339) != "2026-02-23T10:00:00-05:00" {
+		t.Errorf("got last occurrence %v, want 2026-02-23T10:00:00-05:00", last)
+	}
+
+	// A series that's over before ends_on isn't capped.
+	boundary, err = endsOnBoundary([]string{"RRULE:FREQ=WEEKLY;COUNT=4"}, start, "2026-03-02")
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if boundary != nil {
+		t.Errorf("expected nil boundary for a series already over, got %v", boundary)
+	}
+}
+
+func TestRecurrenceSet_DST(t *testing.T) {
+	// Fridays at 14:30 New York time, as the API returns the start: with the
+	// offset in force on its first day. DST begins on the 8th of March 2026.
+	start := &calendar.EventDateTime{DateTime: "2026-02-27T14:30:00-05:00", TimeZone: "America/New_York"}
+	recurrence := []string{
+		"RRULE:FREQ=WEEKLY;COUNT=4",
+		"EXDATE;TZID=America/New_York:20260313T143000",
+	}
+
+	set, err := recurrenceSet(recurrence, start)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	var got []string
+	for _, o := range set.All() {
+		got = append(got, o.Format(time.RFC3339))
+	}
+	want := []string{"2026-02-27T14:30:00-05:00", "2026-03-06T14:30:00-05:00", "2026-03-20T14:30:00-04:00"}
+	if len(got) != len(want) {
+		t.Fatalf("got %v, want %v", got, want)
+	}
+	for i := range want {
+		if got[i] != want[i] {
+			t.Errorf("occurrence %d: got %s, want %s", i, got[i], want[i])
+		}
+	}
+
+	// Truncating after DST lands on the next occurrence's local time.
+	next, err := nextOccurrenceAt(recurrence, start, time.Date(2026, 3, 14, 0, 0, 0, 0, time.UTC))
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if want := time.Date(2026, 3, 20, 18, 30, 0, 0, time.UTC); next == nil || !next.Equal(want) {
+		t.Errorf("got next occurrence %v, want %v", next, want)
+	}
+}
+
+func TestBuildReadSource(t *testing.T) {
+	ctx := context.Background()
+	str := func(v string) tftypes.Value { return tftypes.NewValue(tftypes.String, v) }
+	sourceType := eventSchema(t).Type().TerraformType(ctx).(tftypes.Object).AttributeTypes["source"]
+
+	cases := []struct {
+		name   string
+		source tftypes.Value
+		want   *calendar.EventSource
+	}{
+		{
+			name: "title and url",
+			source: objectValue(sourceType, map[string]tftypes.Value{
+				"title": str("Runbook"),
+				"url":   str("https://example.com/runbook"),
+			}),
+			want: &calendar.EventSource{Title: "Runbook", Url: "https://example.com/runbook"},
+		},
+		{
+			name:   "url only",
+			source: objectValue(sourceType, map[string]tftypes.Value{"url": str("https://example.com/runbook")}),
+			want:   &calendar.EventSource{Url: "https://example.com/runbook"},
+		},
+		{
+			name:   "no source",
+			source: tftypes.NewValue(sourceType, nil),
+		},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			model := eventModel(t, map[string]tftypes.Value{
+				"summary":  str("Standup"),
+				"start":    str("2026-01-05T09:00:00"),
+				"end":      str("2026-01-05T09:15:00"),
+				"timezone": str("America/New_York"),
+				"source":   tc.source,
+			})
+			configured := model.Source
+
+			// An existing source is replaced, or cleared
+			event, diags := (&eventResource{}).buildEvent(ctx, &model, &calendar.Event{
+				Source: &calendar.EventSource{Title: "Old", Url: "https://example.com/old"},
+			})
+			if diags.HasError() {
+				t.Fatalf("unexpected diagnostics: %v", diags)
+			}
+			if (event.Source == nil) != (tc.want == nil) || (tc.want != nil && (event.Source.Title != tc.want.Title || event.Source.Url != tc.want.Url)) {
+				t.Fatalf("built %+v, want %+v", event.Source, tc.want)
+			}
+
+			(&eventResource{}).readEvent(ctx, &model, event)
+			if !model.Source.Equal(configured) {
+				t.Errorf("read back %s, want %s", model.Source, configured)
+			}
+		})
+	}
+}
+
+func TestReadAttendees(t *testing.T) {
+	ctx := context.Background()
+	model := eventResourceModel{
+		Attendees: types.SetNull(types.ObjectType{AttrTypes: attendeeAttrTypes}),
+		Rooms:     types.SetNull(types.ObjectType{AttrTypes: roomAttrTypes}),
+	}
+
+	readAttendees(ctx, nil, &model, []*calendar.EventAttendee{
+		{Email: "alice@example.com", ResponseStatus: "accepted", Organizer: true, Self: true},
+		{Email: "bob@example.com", Optional: true, DisplayName: "Bob", Comment: "Running late", AdditionalGuests: 1, ResponseStatus: "tentative"},
+	})
+
+	var attendees []attendeeModel
+	if diags := model.Attendees.ElementsAs(ctx, &attendees, false); diags.HasError() {
+		t.Fatalf("unexpected diagnostics: %v", diags)
+	}
+	if len(attendees) != 2 {
+		t.Fatalf("got %d attendees, want 2", len(attendees))
+	}
+	for _, a := range attendees {
+		switch a.Email.ValueString() {
+		case "alice@example.com":
+			if !a.DisplayName.IsNull() || !a.Comment.IsNull() {
+				t.Errorf("expected unset display_name and comment to read back null, got %+v", a)
+			}
+			if !a.Organizer.ValueBool() || !a.Self.ValueBool() || a.Optional.ValueBool() {
+				t.Errorf("got %+v", a)
+			}
+		case "bob@example.com":
+			if a.DisplayName.ValueString() != "Bob" || a.Comment.ValueString() != "Running late" ||
+				a.AdditionalGuests.ValueInt64() != 1 || !a.Optional.ValueBool() || a.ResponseStatus.ValueString() != "tentative" {
+				t.Errorf("got %+v", a)
+			}
+		default:
+			t.Errorf("unexpected attendee %s", a.Email)
+		}
+	}
+}
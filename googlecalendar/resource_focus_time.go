@@ -0,0 +1,194 @@
+package googlecalendar
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringdefault"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"google.golang.org/api/calendar/v3"
+)
+
+// Ensure the implementation satisfies the expected interfaces.
+var (
+	_ resource.Resource                   = &focusTimeResource{}
+	_ resource.ResourceWithImportState    = &focusTimeResource{}
+	_ resource.ResourceWithValidateConfig = &focusTimeResource{}
+	_ resource.ResourceWithModifyPlan     = &focusTimeResource{}
+)
+
+// focusTimeResource is the resource implementation.
+type focusTimeResource struct {
+	config *Config
+}
+
+// focusTimeResourceModel describes the resource data model.
+type focusTimeResourceModel struct {
+	ID              types.String    `tfsdk:"id"`
+	Summary         types.String    `tfsdk:"summary"`
+	Start           dateTimeValue   `tfsdk:"start"`
+	End             dateTimeValue   `tfsdk:"end"`
+	Timezone        types.String    `tfsdk:"timezone"`
+	Recurrence      recurrenceValue `tfsdk:"recurrence"`
+	AutoDeclineMode types.String    `tfsdk:"auto_decline_mode"`
+	DeclineMessage  types.String    `tfsdk:"decline_message"`
+	ChatStatus      types.String    `tfsdk:"chat_status"`
+	HTMLLink        types.String    `tfsdk:"html_link"`
+	DeletionPolicy  types.String    `tfsdk:"deletion_policy"`
+}
+
+// NewFocusTimeResource creates a new focus time resource.
+func NewFocusTimeResource() resource.Resource {
+	return &focusTimeResource{}
+}
+
+// Metadata returns the resource type name.
+func (r *focusTimeResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_focus_time"
+}
+
+// Schema defines the schema for the resource.
+func (r *focusTimeResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Manages a Google Calendar focus time event.",
+		Attributes: map[string]schema.Attribute{
+			"id": idAttribute(),
+			"summary": schema.StringAttribute{
+				Description: "The summary or title of the event.",
+				Optional:    true,
+				Computed:    true,
+				Default:     stringdefault.StaticString("Focus time"),
+			},
+			"start":      startAttribute(),
+			"end":        endAttribute(),
+			"timezone":   timezoneAttribute(),
+			"recurrence": recurrenceAttribute(),
+			"auto_decline_mode": schema.StringAttribute{
+				Description: "Whether to decline meeting invitations which overlap the event. One of " +
+					"`declineNone`, `declineAllConflictingInvitations` or " +
+					"`declineOnlyNewConflictingInvitations` (default).",
+				Optional: true,
+				Computed: true,
+				Default:  stringdefault.StaticString("declineOnlyNewConflictingInvitations"),
+				Validators: []validator.String{
+					stringvalidator.OneOf(autoDeclineModes...),
+				},
+			},
+			"decline_message": schema.StringAttribute{
+				Description: "Response message to set if an existing event or new invitation is automatically declined.",
+				Optional:    true,
+			},
+			"chat_status": schema.StringAttribute{
+				Description: "The status to mark the user in Chat and related products during the event. " +
+					"One of `available` or `doNotDisturb` (default).",
+				Optional: true,
+				Computed: true,
+				Default:  stringdefault.StaticString("doNotDisturb"),
+				Validators: []validator.String{
+					stringvalidator.OneOf("available", "doNotDisturb"),
+				},
+			},
+			"html_link":       htmlLinkAttribute(),
+			"deletion_policy": deletionPolicyAttribute(),
+		},
+	}
+}
+
+// Configure adds the provider configured client to the resource.
+func (r *focusTimeResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	r.config = configureResource(req, resp)
+}
+
+// Create creates the resource and sets the initial Terraform state.
+func (r *focusTimeResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	focusTimeKind.create(ctx, r.config.calendar, req, resp)
+}
+
+// Read refreshes the Terraform state with the latest data.
+func (r *focusTimeResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	focusTimeKind.readState(ctx, r.config.calendar, req, resp)
+}
+
+// Update updates the resource and sets the updated Terraform state on success.
+func (r *focusTimeResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	focusTimeKind.update(ctx, r.config.calendar, req, resp)
+}
+
+// Delete deletes the resource and removes the Terraform state on success,
+// honoring deletion_policy (see deleteEvent).
+func (r *focusTimeResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	focusTimeKind.delete(ctx, r.config.calendar, req, resp)
+}
+
+// ValidateConfig checks the time zone, start and end at plan time.
+func (r *focusTimeResource) ValidateConfig(ctx context.Context, req resource.ValidateConfigRequest, resp *resource.ValidateConfigResponse) {
+	var config focusTimeResourceModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &config)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	_, _, _, diags := validateEventTime(config.Start, config.End, config.Timezone)
+	resp.Diagnostics.Append(diags...)
+}
+
+// ModifyPlan plans the configured start and end when the time zone changes
+// (see planTimeZoneChange).
+func (r *focusTimeResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	planTimeZoneChange(ctx, req, resp)
+}
+
+// ImportState imports an existing focus time event by its Google Calendar
+// event ID.
+func (r *focusTimeResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	focusTimeKind.importState(ctx, r.config.calendar, req, resp)
+}
+
+// focusTimeKind manages focus time events.
+var focusTimeKind = eventKind[focusTimeResourceModel, *focusTimeResourceModel]{
+	eventType: "focusTime",
+	name:      "focus time event",
+	build:     buildFocusTime,
+	read:      readFocusTime,
+}
+
+func (m *focusTimeResourceModel) id() *types.String { return &m.ID }
+
+func (m *focusTimeResourceModel) deletion() (string, bool) {
+	return m.DeletionPolicy.ValueString(), !m.Recurrence.IsNull()
+}
+
+// buildFocusTime sets event's fields from the Terraform model.
+func buildFocusTime(ctx context.Context, model *focusTimeResourceModel, event *calendar.Event) diag.Diagnostics {
+	event.Summary = model.Summary.ValueString()
+
+	// Set date/time fields and recurrence
+	diags := buildEventTime(ctx, model.Start, model.End, model.Timezone, model.Recurrence, event)
+
+	event.FocusTimeProperties = &calendar.EventFocusTimeProperties{
+		AutoDeclineMode: model.AutoDeclineMode.ValueString(),
+		DeclineMessage:  model.DeclineMessage.ValueString(),
+		ChatStatus:      model.ChatStatus.ValueString(),
+	}
+
+	return diags
+}
+
+// readFocusTime updates the Terraform model from a calendar.Event.
+func readFocusTime(model *focusTimeResourceModel, event *calendar.Event) {
+	model.Summary = types.StringValue(event.Summary)
+
+	readEventTime(event, &model.Start, &model.End, &model.Timezone, &model.Recurrence)
+
+	if event.FocusTimeProperties != nil {
+		model.AutoDeclineMode = types.StringValue(event.FocusTimeProperties.AutoDeclineMode)
+		model.ChatStatus = types.StringValue(event.FocusTimeProperties.ChatStatus)
+		model.DeclineMessage = optionalString(event.FocusTimeProperties.DeclineMessage)
+	}
+
+	// Set computed fields
+	model.HTMLLink = types.StringValue(event.HtmlLink)
+}
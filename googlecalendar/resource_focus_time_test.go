@@ -0,0 +1,55 @@
+package googlecalendar
+
+import (
+	"context"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"google.golang.org/api/calendar/v3"
+)
+
+func TestBuildReadFocusTime(t *testing.T) {
+	model := focusTimeResourceModel{
+		Summary:         types.StringValue("Deep work"),
+		Start:           newDateTimeValue("2026-03-02T09:00:00"),
+		End:             newDateTimeValue("2026-03-02T12:00:00"),
+		Timezone:        types.StringValue("America/Los_Angeles"),
+		Recurrence:      newRecurrenceValue([]string{"RRULE:FREQ=WEEKLY;BYDAY=MO,WE"}),
+		AutoDeclineMode: types.StringValue("declineOnlyNewConflictingInvitations"),
+		DeclineMessage:  types.StringValue("Heads down - ping me in chat"),
+		ChatStatus:      types.StringValue("available"),
+	}
+
+	event := &calendar.Event{}
+	if diags := buildFocusTime(context.Background(), &model, event); diags.HasError() {
+		t.Fatalf("unexpected diagnostics: %v", diags)
+	}
+	if event.FocusTimeProperties.ChatStatus != "available" {
+		t.Errorf("got chat status %q", event.FocusTimeProperties.ChatStatus)
+	}
+
+	var got focusTimeResourceModel
+	readFocusTime(&got, event)
+
+	if got.Summary != model.Summary || got.AutoDeclineMode != model.AutoDeclineMode ||
+		got.DeclineMessage != model.DeclineMessage || got.ChatStatus != model.ChatStatus {
+		t.Errorf("got %+v, want %+v", got, model)
+	}
+	if !got.Recurrence.Equal(model.Recurrence) {
+		t.Errorf("got recurrence %v, want %v", got.Recurrence, model.Recurrence)
+	}
+
+	event.FocusTimeProperties.DeclineMessage = ""
+	readFocusTime(&got, event)
+	if !got.DeclineMessage.IsNull() {
+		t.Errorf("expected an unset decline message to read back null, got %v", got.DeclineMessage)
+	}
+}
+
+func TestFocusTimeValidateConfig(t *testing.T) {
+	for _, tc := range eventTimeCases {
+		t.Run(tc.name, func(t *testing.T) {
+			checkValidateConfig(t, &focusTimeResource{}, tc.attrs, tc.wantErr)
+		})
+	}
+}
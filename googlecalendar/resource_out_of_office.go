@@ -0,0 +1,181 @@
+package googlecalendar
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringdefault"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"google.golang.org/api/calendar/v3"
+)
+
+// Ensure the implementation satisfies the expected interfaces.
+var (
+	_ resource.Resource                   = &outOfOfficeResource{}
+	_ resource.ResourceWithImportState    = &outOfOfficeResource{}
+	_ resource.ResourceWithValidateConfig = &outOfOfficeResource{}
+	_ resource.ResourceWithModifyPlan     = &outOfOfficeResource{}
+)
+
+// outOfOfficeResource is the resource implementation.
+type outOfOfficeResource struct {
+	config *Config
+}
+
+// outOfOfficeResourceModel describes the resource data model.
+type outOfOfficeResourceModel struct {
+	ID              types.String    `tfsdk:"id"`
+	Summary         types.String    `tfsdk:"summary"`
+	Start           dateTimeValue   `tfsdk:"start"`
+	End             dateTimeValue   `tfsdk:"end"`
+	Timezone        types.String    `tfsdk:"timezone"`
+	Recurrence      recurrenceValue `tfsdk:"recurrence"`
+	AutoDeclineMode types.String    `tfsdk:"auto_decline_mode"`
+	DeclineMessage  types.String    `tfsdk:"decline_message"`
+	HTMLLink        types.String    `tfsdk:"html_link"`
+	DeletionPolicy  types.String    `tfsdk:"deletion_policy"`
+}
+
+// NewOutOfOfficeResource creates a new out of office resource.
+func NewOutOfOfficeResource() resource.Resource {
+	return &outOfOfficeResource{}
+}
+
+// Metadata returns the resource type name.
+func (r *outOfOfficeResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_out_of_office"
+}
+
+// Schema defines the schema for the resource.
+func (r *outOfOfficeResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Manages a Google Calendar out of office event.",
+		Attributes: map[string]schema.Attribute{
+			"id": idAttribute(),
+			"summary": schema.StringAttribute{
+				Description: "The summary or title of the event.",
+				Optional:    true,
+				Computed:    true,
+				Default:     stringdefault.StaticString("Out of office"),
+			},
+			"start":      startAttribute(),
+			"end":        endAttribute(),
+			"timezone":   timezoneAttribute(),
+			"recurrence": recurrenceAttribute(),
+			"auto_decline_mode": schema.StringAttribute{
+				Description: "Whether to decline meeting invitations which overlap the event. One of " +
+					"`declineNone`, `declineAllConflictingInvitations` or " +
+					"`declineOnlyNewConflictingInvitations` (default).",
+				Optional: true,
+				Computed: true,
+				Default:  stringdefault.StaticString("declineOnlyNewConflictingInvitations"),
+				Validators: []validator.String{
+					stringvalidator.OneOf(autoDeclineModes...),
+				},
+			},
+			"decline_message": schema.StringAttribute{
+				Description: "Response message to set if an existing event or new invitation is automatically declined.",
+				Optional:    true,
+			},
+			"html_link":       htmlLinkAttribute(),
+			"deletion_policy": deletionPolicyAttribute(),
+		},
+	}
+}
+
+// Configure adds the provider configured client to the resource.
+func (r *outOfOfficeResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	r.config = configureResource(req, resp)
+}
+
+// Create creates the resource and sets the initial Terraform state.
+func (r *outOfOfficeResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	outOfOfficeKind.create(ctx, r.config.calendar, req, resp)
+}
+
+// Read refreshes the Terraform state with the latest data.
+func (r *outOfOfficeResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	outOfOfficeKind.readState(ctx, r.config.calendar, req, resp)
+}
+
+// Update updates the resource and sets the updated Terraform state on success.
+func (r *outOfOfficeResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	outOfOfficeKind.update(ctx, r.config.calendar, req, resp)
+}
+
+// Delete deletes the resource and removes the Terraform state on success,
+// honoring deletion_policy (see deleteEvent).
+func (r *outOfOfficeResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	outOfOfficeKind.delete(ctx, r.config.calendar, req, resp)
+}
+
+// ValidateConfig checks the time zone, start and end at plan time.
+func (r *outOfOfficeResource) ValidateConfig(ctx context.Context, req resource.ValidateConfigRequest, resp *resource.ValidateConfigResponse) {
+	var config outOfOfficeResourceModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &config)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	_, _, _, diags := validateEventTime(config.Start, config.End, config.Timezone)
+	resp.Diagnostics.Append(diags...)
+}
+
+// ModifyPlan plans the configured start and end when the time zone changes
+// (see planTimeZoneChange).
+func (r *outOfOfficeResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	planTimeZoneChange(ctx, req, resp)
+}
+
+// ImportState imports an existing out of office event by its Google Calendar
+// event ID.
+func (r *outOfOfficeResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	outOfOfficeKind.importState(ctx, r.config.calendar, req, resp)
+}
+
+// outOfOfficeKind manages out of office events.
+var outOfOfficeKind = eventKind[outOfOfficeResourceModel, *outOfOfficeResourceModel]{
+	eventType: "outOfOffice",
+	name:      "out of office event",
+	build:     buildOutOfOffice,
+	read:      readOutOfOffice,
+}
+
+func (m *outOfOfficeResourceModel) id() *types.String { return &m.ID }
+
+func (m *outOfOfficeResourceModel) deletion() (string, bool) {
+	return m.DeletionPolicy.ValueString(), !m.Recurrence.IsNull()
+}
+
+// buildOutOfOffice sets event's fields from the Terraform model.
+func buildOutOfOffice(ctx context.Context, model *outOfOfficeResourceModel, event *calendar.Event) diag.Diagnostics {
+	event.Summary = model.Summary.ValueString()
+
+	// Set date/time fields and recurrence
+	diags := buildEventTime(ctx, model.Start, model.End, model.Timezone, model.Recurrence, event)
+
+	event.OutOfOfficeProperties = &calendar.EventOutOfOfficeProperties{
+		AutoDeclineMode: model.AutoDeclineMode.ValueString(),
+		DeclineMessage:  model.DeclineMessage.ValueString(),
+	}
+
+	return diags
+}
+
+// readOutOfOffice updates the Terraform model from a calendar.Event.
+func readOutOfOffice(model *outOfOfficeResourceModel, event *calendar.Event) {
+	model.Summary = types.StringValue(event.Summary)
+
+	readEventTime(event, &model.Start, &model.End, &model.Timezone, &model.Recurrence)
+
+	if event.OutOfOfficeProperties != nil {
+		model.AutoDeclineMode = types.StringValue(event.OutOfOfficeProperties.AutoDeclineMode)
+		model.DeclineMessage = optionalString(event.OutOfOfficeProperties.DeclineMessage)
+	}
+
+	// Set computed fields
+	model.HTMLLink = types.StringValue(event.HtmlLink)
+}
@@ -0,0 +1,58 @@
+package googlecalendar
+
+import (
+	"context"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"google.golang.org/api/calendar/v3"
+)
+
+func TestBuildReadOutOfOffice(t *testing.T) {
+	model := outOfOfficeResourceModel{
+		Summary:         types.StringValue("Vacation"),
+		Start:           newDateTimeValue("2026-07-06T00:00:00"),
+		End:             newDateTimeValue("2026-07-11T00:00:00"),
+		Timezone:        types.StringValue("Europe/Paris"),
+		Recurrence:      newRecurrenceNull(),
+		AutoDeclineMode: types.StringValue("declineAllConflictingInvitations"),
+		DeclineMessage:  types.StringNull(),
+	}
+
+	event := &calendar.Event{}
+	if diags := buildOutOfOffice(context.Background(), &model, event); diags.HasError() {
+		t.Fatalf("unexpected diagnostics: %v", diags)
+	}
+	if event.OutOfOfficeProperties.AutoDeclineMode != "declineAllConflictingInvitations" {
+		t.Errorf("got auto decline mode %q", event.OutOfOfficeProperties.AutoDeclineMode)
+	}
+
+	// As the API returns it, with an offset.
+	event.Start.DateTime = "2026-07-06T00:00:00+02:00"
+	event.End.DateTime = "2026-07-11T00:00:00+02:00"
+	event.HtmlLink = "https://www.google.com/calendar/event?eid=abc"
+
+	var got outOfOfficeResourceModel
+	readOutOfOffice(&got, event)
+
+	if !got.DeclineMessage.IsNull() {
+		t.Errorf("expected an unset decline message to read back null, got %v", got.DeclineMessage)
+	}
+	if got.Summary != model.Summary || got.AutoDeclineMode != model.AutoDeclineMode || got.Timezone != model.Timezone {
+		t.Errorf("got %+v, want %+v", got, model)
+	}
+	if equal, _ := model.Start.StringSemanticEquals(context.Background(), got.Start); !equal {
+		t.Errorf("start %s doesn't match %s", got.Start, model.Start)
+	}
+	if !got.Recurrence.IsNull() {
+		t.Errorf("expected no recurrence, got %v", got.Recurrence)
+	}
+}
+
+func TestOutOfOfficeValidateConfig(t *testing.T) {
+	for _, tc := range eventTimeCases {
+		t.Run(tc.name, func(t *testing.T) {
+			checkValidateConfig(t, &outOfOfficeResource{}, tc.attrs, tc.wantErr)
+		})
+	}
+}
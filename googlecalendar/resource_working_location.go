@@ -0,0 +1,320 @@
+package googlecalendar
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/boolvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/resourcevalidator"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	fwpath "github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-framework/types/basetypes"
+	"google.golang.org/api/calendar/v3"
+)
+
+// Ensure the implementation satisfies the expected interfaces.
+var (
+	_ resource.Resource                     = &workingLocationResource{}
+	_ resource.ResourceWithImportState      = &workingLocationResource{}
+	_ resource.ResourceWithConfigValidators = &workingLocationResource{}
+	_ resource.ResourceWithValidateConfig   = &workingLocationResource{}
+	_ resource.ResourceWithModifyPlan       = &workingLocationResource{}
+)
+
+// workingLocationResource is the resource implementation.
+type workingLocationResource struct {
+	config *Config
+}
+
+// workingLocationResourceModel describes the resource data model.
+type workingLocationResourceModel struct {
+	ID             types.String    `tfsdk:"id"`
+	Summary        types.String    `tfsdk:"summary"`
+	Start          dateTimeValue   `tfsdk:"start"`
+	End            dateTimeValue   `tfsdk:"end"`
+	Timezone       types.String    `tfsdk:"timezone"`
+	Recurrence     recurrenceValue `tfsdk:"recurrence"`
+	HomeOffice     types.Bool      `tfsdk:"home_office"`
+	OfficeLocation types.Object    `tfsdk:"office_location"`
+	CustomLocation types.Object    `tfsdk:"custom_location"`
+	HTMLLink       types.String    `tfsdk:"html_link"`
+	DeletionPolicy types.String    `tfsdk:"deletion_policy"`
+}
+
+// officeLocationModel describes the office_location nested object.
+type officeLocationModel struct {
+	BuildingID types.String `tfsdk:"building_id"`
+	FloorID    types.String `tfsdk:"floor_id"`
+	DeskID     types.String `tfsdk:"desk_id"`
+	Label      types.String `tfsdk:"label"`
+}
+
+// officeLocationAttrTypes are the attribute types of the office_location
+// nested object.
+var officeLocationAttrTypes = map[string]attr.Type{
+	"building_id": types.StringType,
+	"floor_id":    types.StringType,
+	"desk_id":     types.StringType,
+	"label":       types.StringType,
+}
+
+// customLocationModel describes the custom_location nested object.
+type customLocationModel struct {
+	Label types.String `tfsdk:"label"`
+}
+
+// customLocationAttrTypes are the attribute types of the custom_location
+// nested object.
+var customLocationAttrTypes = map[string]attr.Type{
+	"label": types.StringType,
+}
+
+// NewWorkingLocationResource creates a new working location resource.
+func NewWorkingLocationResource() resource.Resource {
+	return &workingLocationResource{}
+}
+
+// Metadata returns the resource type name.
+func (r *workingLocationResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_working_location"
+}
+
+// Schema defines the schema for the resource.
+func (r *workingLocationResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Manages a Google Calendar working location event. Exactly one of `home_office`, " +
+			"`office_location` or `custom_location` must be set.",
+		Attributes: map[string]schema.Attribute{
+			"id": idAttribute(),
+			"summary": schema.StringAttribute{
+				Description: "The summary or title of the event. Defaults to one the calendar derives from the location.",
+				Optional:    true,
+				Computed:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"start":      startAttribute(),
+			"end":        endAttribute(),
+			"timezone":   timezoneAttribute(),
+			"recurrence": recurrenceAttribute(),
+			"home_office": schema.BoolAttribute{
+				Description: "Set to true when working from home. Leave it out, rather than setting it " +
+					"to false, when working elsewhere.",
+				Optional: true,
+				Validators: []validator.Bool{
+					boolvalidator.Equals(true),
+				},
+			},
+			"html_link":       htmlLinkAttribute(),
+			"deletion_policy": deletionPolicyAttribute(),
+		},
+		Blocks: map[string]schema.Block{
+			"office_location": schema.SingleNestedBlock{
+				Description: "Working from an office.",
+				Attributes: map[string]schema.Attribute{
+					"building_id": schema.StringAttribute{
+						Description: "The building identifier, which should match the buildingId in the organization's Resources database.",
+						Optional:    true,
+					},
+					"floor_id": schema.StringAttribute{
+						Description: "The floor identifier.",
+						Optional:    true,
+					},
+					"desk_id": schema.StringAttribute{
+						Description: "The desk identifier.",
+						Optional:    true,
+					},
+					"label": schema.StringAttribute{
+						Description: "The office name that's displayed in Calendar Web and Mobile clients.",
+						Optional:    true,
+					},
+				},
+			},
+			"custom_location": schema.SingleNestedBlock{
+				Description: "Working from some other location.",
+				Attributes: map[string]schema.Attribute{
+					"label": schema.StringAttribute{
+						Description: "The label of the custom location.",
+						Optional:    true,
+					},
+				},
+			},
+		},
+	}
+}
+
+// ConfigValidators returns validators that check the resource configuration
+// as a whole.
+func (r *workingLocationResource) ConfigValidators(ctx context.Context) []resource.ConfigValidator {
+	return []resource.ConfigValidator{
+		resourcevalidator.ExactlyOneOf(
+			fwpath.MatchRoot("home_office"),
+			fwpath.MatchRoot("office_location"),
+			fwpath.MatchRoot("custom_location"),
+		),
+	}
+}
+
+// Configure adds the provider configured client to the resource.
+func (r *workingLocationResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	r.config = configureResource(req, resp)
+}
+
+// Create creates the resource and sets the initial Terraform state.
+func (r *workingLocationResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	workingLocationKind.create(ctx, r.config.calendar, req, resp)
+}
+
+// Read refreshes the Terraform state with the latest data.
+func (r *workingLocationResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	workingLocationKind.readState(ctx, r.config.calendar, req, resp)
+}
+
+// Update updates the resource and sets the updated Terraform state on success.
+func (r *workingLocationResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	workingLocationKind.update(ctx, r.config.calendar, req, resp)
+}
+
+// Delete deletes the resource and removes the Terraform state on success,
+// honoring deletion_policy (see deleteEvent).
+func (r *workingLocationResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	workingLocationKind.delete(ctx, r.config.calendar, req, resp)
+}
+
+// ValidateConfig checks the time zone, start and end at plan time.
+func (r *workingLocationResource) ValidateConfig(ctx context.Context, req resource.ValidateConfigRequest, resp *resource.ValidateConfigResponse) {
+	var config workingLocationResourceModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &config)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	_, _, _, diags := validateEventTime(config.Start, config.End, config.Timezone)
+	resp.Diagnostics.Append(diags...)
+}
+
+// ModifyPlan plans the configured start and end when the time zone changes
+// (see planTimeZoneChange).
+func (r *workingLocationResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	planTimeZoneChange(ctx, req, resp)
+}
+
+// ImportState imports an existing working location event by its Google
+// Calendar event ID.
+func (r *workingLocationResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	workingLocationKind.importState(ctx, r.config.calendar, req, resp)
+}
+
+// workingLocationKind manages working location events.
+var workingLocationKind = eventKind[workingLocationResourceModel, *workingLocationResourceModel]{
+	eventType: "workingLocation",
+	name:      "working location event",
+	build:     buildWorkingLocation,
+	read:      readWorkingLocation,
+}
+
+func (m *workingLocationResourceModel) id() *types.String { return &m.ID }
+
+func (m *workingLocationResourceModel) deletion() (string, bool) {
+	return m.DeletionPolicy.ValueString(), !m.Recurrence.IsNull()
+}
+
+// buildWorkingLocation sets event's fields from the Terraform model.
+func buildWorkingLocation(ctx context.Context, model *workingLocationResourceModel, event *calendar.Event) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	if !model.Summary.IsNull() && !model.Summary.IsUnknown() {
+		event.Summary = model.Summary.ValueString()
+	}
+
+	// Working location events must be public and shown as available.
+	event.Visibility = "public"
+	event.Transparency = boolToTransparency(true)
+
+	// Set date/time fields and recurrence
+	diags = append(diags, buildEventTime(ctx, model.Start, model.End, model.Timezone, model.Recurrence, event)...)
+
+	switch {
+	case model.HomeOffice.ValueBool():
+		event.WorkingLocationProperties = &calendar.EventWorkingLocationProperties{
+			Type:       "homeOffice",
+			HomeOffice: map[string]any{},
+		}
+	case !model.OfficeLocation.IsNull() && !model.OfficeLocation.IsUnknown():
+		var office officeLocationModel
+		diags = append(diags, model.OfficeLocation.As(ctx, &office, basetypes.ObjectAsOptions{})...)
+		event.WorkingLocationProperties = &calendar.EventWorkingLocationProperties{
+			Type: "officeLocation",
+			OfficeLocation: &calendar.EventWorkingLocationPropertiesOfficeLocation{
+				BuildingId: office.BuildingID.ValueString(),
+				FloorId:    office.FloorID.ValueString(),
+				DeskId:     office.DeskID.ValueString(),
+				Label:      office.Label.ValueString(),
+			},
+		}
+	case !model.CustomLocation.IsNull() && !model.CustomLocation.IsUnknown():
+		var custom customLocationModel
+		diags = append(diags, model.CustomLocation.As(ctx, &custom, basetypes.ObjectAsOptions{})...)
+		event.WorkingLocationProperties = &calendar.EventWorkingLocationProperties{
+			Type: "customLocation",
+			CustomLocation: &calendar.EventWorkingLocationPropertiesCustomLocation{
+				Label: custom.Label.ValueString(),
+			},
+		}
+	default:
+		diags.AddError(
+			"Missing working location",
+			"Exactly one of home_office = true, office_location or custom_location must be set.",
+		)
+	}
+
+	return diags
+}
+
+// readWorkingLocation updates the Terraform model from a calendar.Event.
+func readWorkingLocation(model *workingLocationResourceModel, event *calendar.Event) {
+	model.Summary = types.StringValue(event.Summary)
+
+	readEventTime(event, &model.Start, &model.End, &model.Timezone, &model.Recurrence)
+
+	model.HomeOffice = types.BoolNull()
+	model.OfficeLocation = types.ObjectNull(officeLocationAttrTypes)
+	model.CustomLocation = types.ObjectNull(customLocationAttrTypes)
+
+	if props := event.WorkingLocationProperties; props != nil {
+		switch props.Type {
+		case "homeOffice":
+			model.HomeOffice = types.BoolValue(true)
+		case "officeLocation":
+			if props.OfficeLocation != nil {
+				model.OfficeLocation, _ = types.ObjectValue(
+					officeLocationAttrTypes,
+					map[string]attr.Value{
+						"building_id": optionalString(props.OfficeLocation.BuildingId),
+						"floor_id":    optionalString(props.OfficeLocation.FloorId),
+						"desk_id":     optionalString(props.OfficeLocation.DeskId),
+						"label":       optionalString(props.OfficeLocation.Label),
+					},
+				)
+			}
+		case "customLocation":
+			if props.CustomLocation != nil {
+				model.CustomLocation, _ = types.ObjectValue(
+					customLocationAttrTypes,
+					map[string]attr.Value{
+						"label": optionalString(props.CustomLocation.Label),
+					},
+				)
+			}
+		}
+	}
+
+	// Set computed fields
+	model.HTMLLink = types.StringValue(event.HtmlLink)
+}
@@ -0,0 +1,80 @@
+package googlecalendar
+
+import (
+	"context"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"google.golang.org/api/calendar/v3"
+)
+
+func TestBuildReadWorkingLocation(t *testing.T) {
+	office := types.ObjectValueMust(officeLocationAttrTypes, map[string]attr.Value{
+		"building_id": types.StringValue("HQ-1"),
+		"floor_id":    types.StringNull(),
+		"desk_id":     types.StringNull(),
+		"label":       types.StringValue("HQ"),
+	})
+	model := workingLocationResourceModel{
+		Summary:        types.StringNull(),
+		Start:          newDateTimeValue("2026-03-02T00:00:00"),
+		End:            newDateTimeValue("2026-03-03T00:00:00"),
+		Timezone:       types.StringValue("Europe/London"),
+		Recurrence:     newRecurrenceNull(),
+		HomeOffice:     types.BoolNull(),
+		OfficeLocation: office,
+		CustomLocation: types.ObjectNull(customLocationAttrTypes),
+	}
+
+	// Updating an event that was a home office day replaces its location.
+	event := &calendar.Event{WorkingLocationProperties: &calendar.EventWorkingLocationProperties{
+		Type:       "homeOffice",
+		HomeOffice: map[string]any{},
+	}}
+	if diags := buildWorkingLocation(context.Background(), &model, event); diags.HasError() {
+		t.Fatalf("unexpected diagnostics: %v", diags)
+	}
+	if props := event.WorkingLocationProperties; props.Type != "officeLocation" || props.HomeOffice != nil ||
+		props.OfficeLocation.BuildingId != "HQ-1" {
+		t.Errorf("got %+v", props)
+	}
+	if event.Visibility != "public" || event.Transparency != "transparent" {
+		t.Errorf("got visibility %q and transparency %q", event.Visibility, event.Transparency)
+	}
+
+	event.Summary = "HQ"
+	var got workingLocationResourceModel
+	readWorkingLocation(&got, event)
+
+	if !got.OfficeLocation.Equal(office) {
+		t.Errorf("got office location %v, want %v", got.OfficeLocation, office)
+	}
+	if !got.HomeOffice.IsNull() || !got.CustomLocation.IsNull() {
+		t.Errorf("expected only office_location to be set, got %+v", got)
+	}
+}
+
+func TestBuildWorkingLocation_Missing(t *testing.T) {
+	model := workingLocationResourceModel{
+		Start:          newDateTimeValue("2026-03-02T00:00:00"),
+		End:            newDateTimeValue("2026-03-03T00:00:00"),
+		Timezone:       types.StringValue("Europe/London"),
+		Recurrence:     newRecurrenceNull(),
+		HomeOffice:     types.BoolValue(false),
+		OfficeLocation: types.ObjectNull(officeLocationAttrTypes),
+		CustomLocation: types.ObjectNull(customLocationAttrTypes),
+	}
+
+	if diags := buildWorkingLocation(context.Background(), &model, &calendar.Event{}); !diags.HasError() {
+		t.Error("expected an error with no location set")
+	}
+}
+
+func TestWorkingLocationValidateConfig(t *testing.T) {
+	for _, tc := range eventTimeCases {
+		t.Run(tc.name, func(t *testing.T) {
+			checkValidateConfig(t, &workingLocationResource{}, tc.attrs, tc.wantErr)
+		})
+	}
+}
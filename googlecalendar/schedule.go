@@ -0,0 +1,200 @@
+package googlecalendar
+
+import (
+	"context"
+	"fmt"
+	"slices"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	fwpath "github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-framework/types/basetypes"
+	"github.com/teambition/rrule-go"
+)
+
+// compileSchedule compiles a schedule block into the RRULE line it stands
+// for. An until date without a time runs to the end of that day in timezone;
+// either way UNTIL is written in UTC, as Google requires for timed events.
+func compileSchedule(ctx context.Context, schedule *scheduleModel, timezone string) ([]string, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	parts := []string{"FREQ=" + strings.ToUpper(schedule.Frequency.ValueString())}
+
+	if !schedule.Interval.IsNull() && !schedule.Interval.IsUnknown() {
+		parts = append(parts, fmt.Sprintf("INTERVAL=%d", schedule.Interval.ValueInt64()))
+	}
+
+	if !schedule.ByDay.IsNull() && !schedule.ByDay.IsUnknown() {
+		var days []string
+		diags = append(diags, schedule.ByDay.ElementsAs(ctx, &days, false)...)
+		if len(days) > 0 {
+			parts = append(parts, "BYDAY="+strings.ToUpper(strings.Join(days, ",")))
+		}
+	}
+
+	for _, ints := range []struct {
+		key  string
+		list types.List
+	}{
+		{"BYMONTHDAY", schedule.ByMonthDay},
+		{"BYSETPOS", schedule.BySetPos},
+	} {
+		if ints.list.IsNull() || ints.list.IsUnknown() {
+			continue
+		}
+		var values []int64
+		diags = append(diags, ints.list.ElementsAs(ctx, &values, false)...)
+		if len(values) == 0 {
+			continue
+		}
+		strs := make([]string, len(values))
+		for i, v := range values {
+			strs[i] = strconv.FormatInt(v, 10)
+		}
+		parts = append(parts, ints.key+"="+strings.Join(strs, ","))
+	}
+
+	if !schedule.Count.IsNull() && !schedule.Count.IsUnknown() {
+		parts = append(parts, fmt.Sprintf("COUNT=%d", schedule.Count.ValueInt64()))
+	}
+
+	if !schedule.Until.IsNull() && !schedule.Until.IsUnknown() {
+		until, err := scheduleUntil(schedule.Until.ValueString(), timezone)
+		if err != nil {
+			diags.AddAttributeError(
+				fwpath.Root("schedule").AtName("until"),
+				"Invalid schedule until",
+				err.Error(),
+			)
+			return nil, diags
+		}
+		parts = append(parts, "UNTIL="+until.Format("20060102T150405Z"))
+	}
+
+	if !schedule.WeekStart.IsNull() && !schedule.WeekStart.IsUnknown() {
+		parts = append(parts, "WKST="+strings.ToUpper(schedule.WeekStart.ValueString()))
+	}
+
+	return []string{"RRULE:" + strings.Join(parts, ";")}, diags
+}
+
+// scheduleUntil parses a schedule's until: a date, meaning the end of that
+// day in timezone, or an RFC3339 date-time. The result is in UTC.
+func scheduleUntil(until, timezone string) (time.Time, error) {
+	if t, err := time.Parse(time.RFC3339, until); err == nil {
+		return t.UTC(), nil
+	}
+
+	date, err := time.Parse(time.DateOnly, until)
+	if err != nil {
+		return time.Time{}, fmt.Errorf("%q is neither a date (YYYY-MM-DD) nor an RFC3339 date-time", until)
+	}
+	loc, err := time.LoadLocation(timezone)
+	if err != nil {
+		return time.Time{}, fmt.Errorf("loading time zone %q: %w", timezone, err)
+	}
+
+	endOfDay := time.Date(date.Year(), date.Month(), date.Day()+1, 0, 0, 0, 0, loc).Add(-time.Second)
+	return endOfDay.UTC(), nil
+}
+
+// decompileSchedule converts recurrence back into a schedule block, if it's a
+// single RRULE using only what the block can express.
+func decompileSchedule(recurrence []string) (types.Object, bool) {
+	if len(recurrence) != 1 {
+		return types.ObjectNull(scheduleAttrTypes), false
+	}
+
+	name, value, err := splitRecurrenceLine(recurrence[0])
+	if err != nil || name != "RRULE" {
+		return types.ObjectNull(scheduleAttrTypes), false
+	}
+	opt, err := rrule.StrToROption(value[1:])
+	if err != nil || opt.Freq > rrule.DAILY {
+		return types.ObjectNull(scheduleAttrTypes), false
+	}
+	if len(opt.Bymonth) > 0 || len(opt.Byyearday) > 0 || len(opt.Byweekno) > 0 || len(opt.Byhour) > 0 ||
+		len(opt.Byminute) > 0 || len(opt.Bysecond) > 0 || len(opt.Byeaster) > 0 {
+		return types.ObjectNull(scheduleAttrTypes), false
+	}
+
+	optionalInt := func(v int) types.Int64 {
+		if v == 0 {
+			return types.Int64Null()
+		}
+		return types.Int64Value(int64(v))
+	}
+	intList := func(values []int) types.List {
+		if len(values) == 0 {
+			return types.ListNull(types.Int64Type)
+		}
+		elements := make([]attr.Value, len(values))
+		for i, v := range values {
+			elements[i] = types.Int64Value(int64(v))
+		}
+		return types.ListValueMust(types.Int64Type, elements)
+	}
+
+	byDay := types.ListNull(types.StringType)
+	if len(opt.Byweekday) > 0 {
+		elements := make([]attr.Value, len(opt.Byweekday))
+		for i, day := range opt.Byweekday {
+			elements[i] = types.StringValue(day.String())
+		}
+		byDay = types.ListValueMust(types.StringType, elements)
+	}
+
+	until := types.StringNull()
+	if !opt.Until.IsZero() {
+		until = types.StringValue(opt.Until.UTC().Format(time.RFC3339))
+	}
+
+	weekStart := types.StringNull()
+	if strings.Contains(value, "WKST=") {
+		weekStart = types.StringValue(opt.Wkst.String())
+	}
+
+	schedule, diags := types.ObjectValue(
+		scheduleAttrTypes,
+		map[string]attr.Value{
+			"frequency":    types.StringValue(strings.ToLower(opt.Freq.String())),
+			"interval":     optionalInt(opt.Interval),
+			"by_day":       byDay,
+			"by_month_day": intList(opt.Bymonthday),
+			"by_set_pos":   intList(opt.Bysetpos),
+			"count":        optionalInt(opt.Count),
+			"until":        until,
+			"week_start":   weekStart,
+		},
+	)
+	return schedule, !diags.HasError()
+}
+
+// readSchedule updates the model's schedule from the event's recurrence. The
+// configured schedule is kept while it still compiles to the same rule;
+// otherwise the recurrence is decompiled into a new schedule, or, failing
+// that, left in recurrence so the difference shows up in the plan.
+func readSchedule(ctx context.Context, model *eventResourceModel, recurrence []string) {
+	var schedule scheduleModel
+	if diags := model.Schedule.As(ctx, &schedule, basetypes.ObjectAsOptions{}); !diags.HasError() {
+		compiled, diags := compileSchedule(ctx, &schedule, model.Timezone.ValueString())
+		want, err := canonicalRecurrence(compiled)
+		if !diags.HasError() && err == nil {
+			if live, err := canonicalRecurrence(recurrence); err == nil && slices.Equal(want, live) {
+				model.Recurrence = newRecurrenceNull()
+				return
+			}
+		}
+	}
+
+	if decompiled, ok := decompileSchedule(recurrence); ok {
+		model.Schedule = decompiled
+		model.Recurrence = newRecurrenceNull()
+		return
+	}
+	model.Schedule = types.ObjectNull(scheduleAttrTypes)
+}
@@ -0,0 +1,197 @@
+package googlecalendar
+
+import (
+	"context"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	fwpath "github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-framework/types/basetypes"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+	"google.golang.org/api/calendar/v3"
+)
+
+func TestCompileSchedule(t *testing.T) {
+	schedule := scheduleModel{
+		Frequency:  types.StringValue("weekly"),
+		Interval:   types.Int64Value(2),
+		ByDay:      types.ListValueMust(types.StringType, []attr.Value{types.StringValue("fr")}),
+		ByMonthDay: types.ListNull(types.Int64Type),
+		BySetPos:   types.ListNull(types.Int64Type),
+		Count:      types.Int64Null(),
+		Until:      types.StringValue("2026-12-31"),
+		WeekStart:  types.StringValue("SU"),
+	}
+
+	lines, diags := compileSchedule(context.Background(), &schedule, "America/New_York")
+	if diags.HasError() {
+		t.Fatalf("unexpected diagnostics: %v", diags)
+	}
+
+	// The last second of 2026-12-31 in New York, in UTC.
+	want := "RRULE:FREQ=WEEKLY;INTERVAL=2;BYDAY=FR;UNTIL=20270101T045959Z;WKST=SU"
+	if len(lines) != 1 || lines[0] != want {
+		t.Errorf("got %v, want [%s]", lines, want)
+	}
+}
+
+func TestDecompileSchedule(t *testing.T) {
+	schedule, ok := decompileSchedule([]string{"RRULE:FREQ=MONTHLY;BYDAY=MO,TU,WE,TH,FR;BYSETPOS=-1;COUNT=12"})
+	if !ok {
+		t.Fatal("expected a simple rule to decompile")
+	}
+
+	var got scheduleModel
+	if diags := schedule.As(context.Background(), &got, basetypes.ObjectAsOptions{}); diags.HasError() {
+		t.Fatalf("unexpected diagnostics: %v", diags)
+	}
+	if got.Frequency.ValueString() != "monthly" || got.Count.ValueInt64() != 12 || !got.Interval.IsNull() {
+		t.Errorf("got %+v", got)
+	}
+	if n := len(got.ByDay.Elements()); n != 5 {
+		t.Errorf("got %d days, want 5", n)
+	}
+
+	if _, ok := decompileSchedule([]string{"RRULE:FREQ=YEARLY;BYMONTH=3", "EXDATE:20260101T000000Z"}); ok {
+		t.Error("expected a rule with an EXDATE not to decompile")
+	}
+	if _, ok := decompileSchedule([]string{"RRULE:FREQ=HOURLY"}); ok {
+		t.Error("expected an hourly rule not to decompile")
+	}
+}
+
+func TestReadEvent(t *testing.T) {
+	ctx := context.Background()
+	s := eventSchema(t)
+	typ := s.Type().TerraformType(ctx)
+	event := &calendar.Event{
+		Id:               "abc",
+		Summary:          "Standup",
+		Start:            &calendar.EventDateTime{DateTime: "2026-01-05T09:00:00-05:00", TimeZone: "America/New_York"},
+		End:              &calendar.EventDateTime{DateTime: "2026-01-05T09:15:00-05:00", TimeZone: "America/New_York"},
+		Recurrence:       []string{"RRULE:FREQ=WEEKLY;BYDAY=MO,WE"},
+		ICalUID:          "abc@google.com",
+		Etag:             `"3181161784712000"`,
+		Created:          "2026-01-01T10:00:00.000Z",
+		Updated:          "2026-01-02T11:00:00.000Z",
+		Organizer:        &calendar.EventOrganizer{Email: "alice@example.com"},
+		Creator:          &calendar.EventCreator{Email: "bob@example.com"},
+		Status:           "confirmed",
+		HangoutLink:      "https://meet.google.com/aaa-bbbb-ccc",
+		RecurringEventId: "parent",
+	}
+
+	cases := []struct {
+		name         string
+		attrs        map[string]tftypes.Value
+		wantSchedule bool
+	}{
+		// Import leaves nothing but the id in state, and the rule is kept
+		// as recurrence lines
+		{"imported", map[string]tftypes.Value{"id": tftypes.NewValue(tftypes.String, "abc")}, false},
+		// A schedule changed outside Terraform is read back as a schedule
+		{"schedule configured", map[string]tftypes.Value{
+			"id": tftypes.NewValue(tftypes.String, "abc"),
+			"schedule": objectValue(typ.(tftypes.Object).AttributeTypes["schedule"], map[string]tftypes.Value{
+				"frequency": tftypes.NewValue(tftypes.String, "monthly"),
+			}),
+		}, true},
+		{"recurrence configured", map[string]tftypes.Value{
+			"id":         tftypes.NewValue(tftypes.String, "abc"),
+			"recurrence": tftypes.NewValue(tftypes.List{ElementType: tftypes.String}, []tftypes.Value{tftypes.NewValue(tftypes.String, "RRULE:FREQ=WEEKLY;BYDAY=MO,WE")}),
+		}, false},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			state := tfsdk.State{Schema: s, Raw: objectValue(typ, tc.attrs)}
+			var model eventResourceModel
+			if diags := state.Get(ctx, &model); diags.HasError() {
+				t.Fatalf("unexpected diagnostics: %v", diags)
+			}
+
+			(&eventResource{}).readEvent(ctx, &model, event)
+
+			for _, attr := range []struct {
+				name string
+				got  types.String
+				want string
+			}{
+				{"ical_uid", model.ICalUID, event.ICalUID},
+				{"etag", model.Etag, event.Etag},
+				{"created", model.Created, event.Created},
+				{"updated", model.Updated, event.Updated},
+				{"organizer_email", model.OrganizerEmail, event.Organizer.Email},
+				{"creator_email", model.CreatorEmail, event.Creator.Email},
+				{"status", model.Status, event.Status},
+				{"hangout_link", model.HangoutLink, event.HangoutLink},
+				{"recurring_event_id", model.RecurringEventID, event.RecurringEventId},
+			} {
+				if attr.got.ValueString() != attr.want {
+					t.Errorf("%s: got %s, want %q", attr.name, attr.got, attr.want)
+				}
+			}
+
+			if tc.wantSchedule {
+				var schedule scheduleModel
+				if diags := model.Schedule.As(ctx, &schedule, basetypes.ObjectAsOptions{}); diags.HasError() {
+					t.Fatalf("schedule: got %s", model.Schedule)
+				}
+				if schedule.Frequency.ValueString() != "weekly" || len(schedule.ByDay.Elements()) != 2 {
+					t.Errorf("got schedule %+v", schedule)
+				}
+				if !model.Recurrence.IsNull() {
+					t.Errorf("recurrence: got %s, want null", model.Recurrence)
+				}
+			} else {
+				if !model.Schedule.IsNull() {
+					t.Errorf("schedule: got %s, want null", model.Schedule)
+				}
+				if model.Recurrence.IsNull() {
+					t.Error("recurrence: got null")
+				}
+			}
+		})
+	}
+
+	// Without an organizer or creator, their emails read back null
+	model := eventModel(t, map[string]tftypes.Value{"id": tftypes.NewValue(tftypes.String, "abc")})
+	(&eventResource{}).readEvent(ctx, &model, &calendar.Event{Id: "abc", Start: event.Start, End: event.End})
+	if !model.OrganizerEmail.IsNull() || !model.CreatorEmail.IsNull() {
+		t.Errorf("got organizer_email %s and creator_email %s, want null", model.OrganizerEmail, model.CreatorEmail)
+	}
+}
+
+func TestScheduleByDay_CaseInsensitive(t *testing.T) {
+	ctx := context.Background()
+	byDay := eventSchema(t).Blocks["schedule"].(schema.SingleNestedBlock).Attributes["by_day"].(schema.ListAttribute)
+
+	for _, tc := range []struct {
+		days    []string
+		wantErr bool
+	}{
+		{[]string{"MO", "-1FR"}, false},
+		{[]string{"mo", "tu", "2we"}, false},
+		{[]string{"monday"}, true},
+	} {
+		values := make([]attr.Value, len(tc.days))
+		for i, day := range tc.days {
+			values[i] = types.StringValue(day)
+		}
+		req := validator.ListRequest{
+			Path:        fwpath.Root("schedule").AtName("by_day"),
+			ConfigValue: types.ListValueMust(types.StringType, values),
+		}
+		for _, v := range byDay.Validators {
+			resp := &validator.ListResponse{}
+			v.ValidateList(ctx, req, resp)
+			if resp.Diagnostics.HasError() != tc.wantErr {
+				t.Errorf("%v: got diagnostics %v", tc.days, resp.Diagnostics)
+			}
+		}
+	}
+}
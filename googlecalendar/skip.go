@@ -0,0 +1,335 @@
+package googlecalendar
+
+import (
+	"context"
+	"fmt"
+	"slices"
+	"strings"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	fwpath "github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/teambition/rrule-go"
+	"google.golang.org/api/calendar/v3"
+)
+
+// skipWindow is a span of time, from inclusive to exclusive, whose
+// occurrences are skipped.
+type skipWindow struct {
+	from, to time.Time
+}
+
+// contains reports whether t falls in the window.
+func (w skipWindow) contains(t time.Time) bool {
+	return !t.Before(w.from) && t.Before(w.to)
+}
+
+// skipWindows returns the spans of time covered by the model's skip blocks:
+// whole days in timezone, from the first day of each through its last.
+func skipWindows(ctx context.Context, skips types.Set, timezone string) ([]skipWindow, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	if skips.IsNull() || skips.IsUnknown() {
+		return nil, diags
+	}
+
+	loc, err := time.LoadLocation(timezone)
+	if err != nil {
+		diags.AddAttributeError(
+			fwpath.Root("timezone"),
+			"Invalid Time Zone",
+			fmt.Sprintf("Could not load time zone %q to expand skip blocks in: %s", timezone, err),
+		)
+		return nil, diags
+	}
+
+	var models []skipModel
+	diags = append(diags, skips.ElementsAs(ctx, &models, false)...)
+	if diags.HasError() {
+		return nil, diags
+	}
+
+	var windows []skipWindow
+	for _, skip := range models {
+		from, to := skip.From, skip.To
+		if !skip.Date.IsNull() {
+			from, to = skip.Date, skip.Date
+		}
+		if from.IsNull() || from.IsUnknown() || to.IsNull() || to.IsUnknown() {
+			continue
+		}
+
+		first, err := time.ParseInLocation(time.DateOnly, from.ValueString(), loc)
+		if err != nil {
+			diags.AddAttributeError(fwpath.Root("skip"), "Invalid Skip Date", err.Error())
+			continue
+		}
+		last, err := time.ParseInLocation(time.DateOnly, to.ValueString(), loc)
+		if err != nil {
+			diags.AddAttributeError(fwpath.Root("skip"), "Invalid Skip Date", err.Error())
+			continue
+		}
+		if last.Before(first) {
+			diags.AddAttributeError(
+				fwpath.Root("skip"),
+				"Invalid Skip Range",
+				fmt.Sprintf("to (%s) must not be before from (%s).", to.ValueString(), from.ValueString()),
+			)
+			continue
+		}
+
+		windows = append(windows, skipWindow{from: first, to: last.AddDate(0, 0, 1)})
+	}
+
+	return windows, diags
+}
+
+// skippedOccurrences returns the occurrences of recurrence, starting from
+// start, that fall in any of windows.
+func skippedOccurrences(recurrence []string, start *calendar.EventDateTime, windows []skipWindow) ([]time.Time, error) {
+	if len(windows) == 0 {
+		return nil, nil
+	}
+
+	set, err := recurrenceSet(recurrence, start)
+	if err != nil {
+		return nil, err
+	}
+
+	var skipped []time.Time
+	for _, w := range windows {
+		for _, t := range set.Between(w.from, w.to, true) {
+			if w.contains(t) {
+				skipped = append(skipped, t)
+			}
+		}
+	}
+
+	return skipped, nil
+}
+
+// exdateLine returns an EXDATE line excluding times, written as local times
+// in the event's time zone - the form Google itself uses.
+func exdateLine(times []time.Time, timezone string) string {
+	loc, err := time.LoadLocation(timezone)
+	if err != nil {
+		loc = time.UTC
+	}
+
+	dates := make([]string, len(times))
+	for i, t := range times {
+		dates[i] = t.In(loc).Format("20060102T150405")
+	}
+
+	return fmt.Sprintf("EXDATE;TZID=%s:%s", loc, strings.Join(dates, ","))
+}
+
+// withoutExdates returns recurrence without the EXDATE dates generated
+// reports the provider added, so only the configured lines are compared with
+// the configuration. Dates the configured lines exclude themselves are kept,
+// wherever they fall. Dates without a TZID or a trailing Z are local times in
+// loc. Lines left empty are dropped; lines that lose only some of their dates
+// are rewritten.
+func withoutExdates(recurrence, configured []string, loc *time.Location, generated func(time.Time) bool) []string {
+	var excluded []time.Time
+	for _, line := range configured {
+		name, value, err := splitRecurrenceLine(line)
+		if err != nil || name != "EXDATE" {
+			continue
+		}
+		if dates, err := rrule.StrToDatesInLoc(value[1:], loc); err == nil {
+			excluded = append(excluded, dates...)
+		}
+	}
+
+	var kept []string
+	for _, line := range recurrence {
+		name, value, err := splitRecurrenceLine(line)
+		if err != nil || name != "EXDATE" {
+			kept = append(kept, line)
+			continue
+		}
+
+		dates, err := rrule.StrToDatesInLoc(value[1:], loc)
+		if err != nil {
+			kept = append(kept, line)
+			continue
+		}
+
+		var remaining []string
+		for _, date := range dates {
+			if generated(date) && !slices.ContainsFunc(excluded, date.Equal) {
+				continue
+			}
+			if date.Location() == time.UTC {
+				remaining = append(remaining, date.Format("20060102T150405Z"))
+			} else {
+				remaining = append(remaining, date.Format("20060102T150405"))
+			}
+		}
+
+		switch {
+		case len(remaining) == len(dates):
+			kept = append(kept, line)
+		case len(remaining) > 0:
+			params := value[:strings.LastIndex(value, ":")]
+			kept = append(kept, name+params+":"+strings.Join(remaining, ","))
+		}
+	}
+
+	return kept
+}
+
+// dayWindows returns a window covering each of dates, as whole days in
+// timezone.
+func dayWindows(dates []string, timezone string) ([]skipWindow, error) {
+	loc, err := time.LoadLocation(timezone)
+	if err != nil {
+		return nil, fmt.Errorf("loading time zone %q: %w", timezone, err)
+	}
+
+	windows := make([]skipWindow, 0, len(dates))
+	for _, date := range dates {
+		day, err := time.ParseInLocation(time.DateOnly, date, loc)
+		if err != nil {
+			return nil, err
+		}
+		windows = append(windows, skipWindow{from: day, to: day.AddDate(0, 0, 1)})
+	}
+
+	return windows, nil
+}
+
+// occurringDays returns those of dates, in order and without repeats, on
+// which recurrence has an occurrence in timezone.
+func occurringDays(recurrence []string, start *calendar.EventDateTime, dates []string, timezone string) ([]string, error) {
+
+	windows, err := dayWindows(dates, timezone)
+	if err != nil {
+		return nil, err
+	}
+	set, err := recurrenceSet(recurrence, start)
+	if err != nil {
+		return nil, err
+	}
+
+	var days []string
+	for i, w := range windows {
+		if len(set.Between(w.from, w.to, true)) > 0 && !slices.Contains(days, dates[i]) {
+			days = append(days, dates[i])
+		}
+	}
+	slices.Sort(days)
+
+	return days, nil
+}
+
+// allDayDates returns the dates covered by the all-day events among events,
+// such as those on a holiday calendar. An all-day event's end date is
+// exclusive.
+func allDayDates(events []*calendar.Event) []string {
+
+	var dates []string
+	for _, e := range events {
+		if e.Start == nil || e.Start.Date == "" || e.End == nil {
+			continue
+		}
+		first, err := time.Parse(time.DateOnly, e.Start.Date)
+		if err != nil {
+			continue
+		}
+		end, err := time.Parse(time.DateOnly, e.End.Date)
+		if err != nil || !end.After(first) {
+			end = first.AddDate(0, 0, 1)
+		}
+		for day := first; day.Before(end); day = day.AddDate(0, 0, 1) {
+			dates = append(dates, day.Format(time.DateOnly))
+		}
+	}
+
+	return dates
+}
+
+// instantWindows returns a window holding just each of times, given as
+// RFC3339 timestamps.
+func instantWindows(times []string) ([]skipWindow, error) {
+
+	windows := make([]skipWindow, 0, len(times))
+	for _, s := range times {
+		t, err := time.Parse(time.RFC3339, s)
+		if err != nil {
+			return nil, err
+		}
+		windows = append(windows, skipWindow{from: t, to: t.Add(time.Nanosecond)})
+	}
+
+	return windows, nil
+}
+
+// eventWindows returns the span of time each of events covers. All-day
+// events cover whole days in loc.
+func eventWindows(events []*calendar.Event, loc *time.Location) []skipWindow {
+
+	var windows []skipWindow
+	for _, e := range events {
+		if e.Start == nil || e.End == nil {
+			continue
+		}
+		if e.Start.Date != "" {
+			from, err := time.ParseInLocation(time.DateOnly, e.Start.Date, loc)
+			if err != nil {
+				continue
+			}
+			to, err := time.ParseInLocation(time.DateOnly, e.End.Date, loc)
+			if err != nil {
+				continue
+			}
+			windows = append(windows, skipWindow{from: from, to: to})
+			continue
+		}
+		from, err := time.Parse(time.RFC3339, e.Start.DateTime)
+		if err != nil {
+			continue
+		}
+		to, err := time.Parse(time.RFC3339, e.End.DateTime)
+		if err != nil {
+			continue
+		}
+		windows = append(windows, skipWindow{from: from, to: to})
+	}
+
+	return windows
+}
+
+// overlapping returns the occurrences, each lasting duration, that overlap
+// any of windows. An occurrence with no duration overlaps the windows it
+// starts in.
+func overlapping(occurrences []time.Time, duration time.Duration, windows []skipWindow) []time.Time {
+
+	var overlaps []time.Time
+	for _, t := range occurrences {
+		for _, w := range windows {
+			if w.contains(t) || (t.Before(w.to) && t.Add(duration).After(w.from)) {
+				overlaps = append(overlaps, t)
+				break
+			}
+		}
+	}
+
+	return overlaps
+}
+
+// eventDuration returns how long an event running from start to end lasts,
+// or zero if either doesn't parse.
+func eventDuration(start, end string) time.Duration {
+	s, _, err := parseDateTime(start)
+	if err != nil {
+		return 0
+	}
+	e, _, err := parseDateTime(end)
+	if err != nil {
+		return 0
+	}
+	return e.Sub(s)
+}
@@ -0,0 +1,117 @@
+package googlecalendar
+
+import (
+	"context"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"google.golang.org/api/calendar/v3"
+)
+
+func TestSkippedOccurrences(t *testing.T) {
+	skip := func(date, from, to types.String) attr.Value {
+		return types.ObjectValueMust(skipAttrTypes, map[string]attr.Value{"date": date, "from": from, "to": to})
+	}
+	skips := types.SetValueMust(types.ObjectType{AttrTypes: skipAttrTypes}, []attr.Value{
+		skip(types.StringValue("2026-12-25"), types.StringNull(), types.StringNull()),
+		skip(types.StringNull(), types.StringValue("2026-12-29"), types.StringValue("2026-12-31")),
+	})
+
+	windows, diags := skipWindows(context.Background(), skips, "America/New_York")
+	if diags.HasError() {
+		t.Fatalf("unexpected diagnostics: %v", diags)
+	}
+
+	// Weekdays at 09:00 New York time.
+	recurrence := []string{"RRULE:FREQ=WEEKLY;BYDAY=MO,TU,WE,TH,FR"}
+	start := &calendar.EventDateTime{DateTime: "2026-12-21T09:00:00", TimeZone: "America/New_York"}
+
+	skipped, err := skippedOccurrences(recurrence, start, windows)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	want := "EXDATE;TZID=America/New_York:20261225T090000,20261229T090000,20261230T090000,20261231T090000"
+	if got := exdateLine(skipped, "America/New_York"); got != want {
+		t.Errorf("got %s, want %s", got, want)
+	}
+}
+
+func TestWithoutExdates(t *testing.T) {
+	loc, _ := time.LoadLocation("Europe/London")
+	window := skipWindow{
+		from: time.Date(2026, 12, 25, 0, 0, 0, 0, loc),
+		to:   time.Date(2026, 12, 26, 0, 0, 0, 0, loc),
+	}
+
+	recurrence := []string{
+		"RRULE:FREQ=DAILY",
+		"EXDATE;TZID=Europe/London:20261225T100000",
+		"EXDATE;TZID=Europe/London:20261201T100000,20261225T100000",
+	}
+	got := withoutExdates(recurrence, []string{"RRULE:FREQ=DAILY"}, loc, window.contains)
+
+	want := []string{"RRULE:FREQ=DAILY", "EXDATE;TZID=Europe/London:20261201T100000"}
+	if strings.Join(got, "\n") != strings.Join(want, "\n") {
+		t.Errorf("got %v, want %v", got, want)
+	}
+
+	// A date the configuration excludes itself stays, even inside a window,
+	// however the API writes it back
+	configured := []string{"RRULE:FREQ=DAILY", "EXDATE;TZID=Europe/London:20261225T100000"}
+	live := []string{"RRULE:FREQ=DAILY", "EXDATE:20261225T100000Z", "EXDATE;TZID=Europe/London:20261225T150000"}
+	got = withoutExdates(live, configured, loc, window.contains)
+
+	want = []string{"RRULE:FREQ=DAILY", "EXDATE:20261225T100000Z"}
+	if strings.Join(got, "\n") != strings.Join(want, "\n") {
+		t.Errorf("configured: got %v, want %v", got, want)
+	}
+}
+
+func TestOccurringDays(t *testing.T) {
+	holidays := allDayDates([]*calendar.Event{
+		{Start: &calendar.EventDateTime{Date: "2026-11-26"}, End: &calendar.EventDateTime{Date: "2026-11-28"}},
+		{Start: &calendar.EventDateTime{Date: "2026-12-25"}, End: &calendar.EventDateTime{Date: "2026-12-26"}},
+		{Start: &calendar.EventDateTime{DateTime: "2026-12-01T10:00:00Z"}, End: &calendar.EventDateTime{DateTime: "2026-12-01T11:00:00Z"}},
+	})
+	if want := "2026-11-26,2026-11-27,2026-12-25"; strings.Join(holidays, ",") != want {
+		t.Fatalf("got %v, want %s", holidays, want)
+	}
+
+	// Fridays at 14:00 London time: the 27th of November and the 25th of
+	// December are Fridays, the 26th of November isn't.
+	recurrence := []string{"RRULE:FREQ=WEEKLY;BYDAY=FR"}
+	start := &calendar.EventDateTime{DateTime: "2026-11-06T14:00:00", TimeZone: "Europe/London"}
+
+	days, err := occurringDays(recurrence, start, holidays, "Europe/London")
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if want := "2026-11-27,2026-12-25"; strings.Join(days, ",") != want {
+		t.Errorf("got %v, want %s", days, want)
+	}
+}
+
+func TestOverlapping(t *testing.T) {
+	loc, _ := time.LoadLocation("America/Chicago")
+	away := eventWindows([]*calendar.Event{
+		// Out all day on the 8th.
+		{Start: &calendar.EventDateTime{Date: "2026-12-08"}, End: &calendar.EventDateTime{Date: "2026-12-09"}},
+		// Out from 15:30 on the 15th, Chicago time.
+		{Start: &calendar.EventDateTime{DateTime: "2026-12-15T21:30:00Z"}, End: &calendar.EventDateTime{DateTime: "2026-12-16T00:00:00Z"}},
+	}, loc)
+
+	// Tuesdays at 15:00 Chicago time, for an hour.
+	var occurrences []time.Time
+	for _, day := range []int{1, 8, 15, 22} {
+		occurrences = append(occurrences, time.Date(2026, 12, day, 15, 0, 0, 0, loc))
+	}
+
+	got := overlapping(occurrences, eventDuration("2026-12-01T15:00:00", "2026-12-01T16:00:00"), away)
+	if len(got) != 2 || got[0].Day() != 8 || got[1].Day() != 15 {
+		t.Errorf("got %v, want the 8th and 15th", got)
+	}
+}
@@ -0,0 +1,153 @@
+package googlecalendar
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/types/basetypes"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+)
+
+// Ensure the implementations satisfy the expected interfaces.
+var (
+	_ basetypes.StringTypable                    = dateTimeType{}
+	_ basetypes.StringValuableWithSemanticEquals = dateTimeValue{}
+)
+
+// localDateTimeLayout is an RFC3339 date-time without a UTC offset, which the
+// API interprets in the event's time zone.
+const localDateTimeLayout = "2006-01-02T15:04:05"
+
+// dateTimeType is a string type for an event's start or end. The API returns
+// these with an explicit UTC offset even when they were written without one,
+// so values naming the same time are semantically equal and the configured
+// spelling is kept in state.
+type dateTimeType struct {
+	basetypes.StringType
+}
+
+// Equal returns true if the given type is equivalent.
+func (t dateTimeType) Equal(o attr.Type) bool {
+	other, ok := o.(dateTimeType)
+	if !ok {
+		return false
+	}
+	return t.StringType.Equal(other.StringType)
+}
+
+// String returns a human readable string of the type name.
+func (t dateTimeType) String() string {
+	return "dateTimeType"
+}
+
+// ValueFromString returns a StringValuable type given a StringValue.
+func (t dateTimeType) ValueFromString(ctx context.Context, in basetypes.StringValue) (basetypes.StringValuable, diag.Diagnostics) {
+	return dateTimeValue{StringValue: in}, nil
+}
+
+// ValueFromTerraform returns a Value given a tftypes.Value.
+func (t dateTimeType) ValueFromTerraform(ctx context.Context, in tftypes.Value) (attr.Value, error) {
+	attrValue, err := t.StringType.ValueFromTerraform(ctx, in)
+	if err != nil {
+		return nil, err
+	}
+
+	stringValue, ok := attrValue.(basetypes.StringValue)
+	if !ok {
+		return nil, fmt.Errorf("unexpected value type of %T", attrValue)
+	}
+
+	return dateTimeValue{StringValue: stringValue}, nil
+}
+
+// ValueType returns the Value type.
+func (t dateTimeType) ValueType(ctx context.Context) attr.Value {
+	return dateTimeValue{}
+}
+
+// dateTimeValue is a value of dateTimeType.
+type dateTimeValue struct {
+	basetypes.StringValue
+}
+
+// newDateTimeValue returns a known dateTimeValue.
+func newDateTimeValue(s string) dateTimeValue {
+	return dateTimeValue{StringValue: basetypes.NewStringValue(s)}
+}
+
+// Equal returns true if the given value is equivalent.
+func (v dateTimeValue) Equal(o attr.Value) bool {
+	other, ok := o.(dateTimeValue)
+	if !ok {
+		return false
+	}
+	return v.StringValue.Equal(other.StringValue)
+}
+
+// Type returns the value's type.
+func (v dateTimeValue) Type(ctx context.Context) attr.Type {
+	return dateTimeType{}
+}
+
+// StringSemanticEquals returns true if the two values name the same time.
+// When both carry a UTC offset they're compared as instants. When either
+// doesn't, it's a wall-clock time in the event's time zone, and is compared
+// with the other's wall-clock time - readEventTime expresses the API's values
+// in the event's time zone, so that's equivalent to comparing instants there
+// as long as the time zone stays the same; planTimeZoneChange covers a change.
+func (v dateTimeValue) StringSemanticEquals(ctx context.Context, newValuable basetypes.StringValuable) (bool, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	newValue, ok := newValuable.(dateTimeValue)
+	if !ok {
+		diags.AddError(
+			"Semantic Equality Check Error",
+			fmt.Sprintf("Expected value type %T but got value type %T.", v, newValuable),
+		)
+		return false, diags
+	}
+
+	prior, priorHasOffset, err := parseDateTime(v.ValueString())
+	if err != nil {
+		return false, diags
+	}
+	current, currentHasOffset, err := parseDateTime(newValue.ValueString())
+	if err != nil {
+		return false, diags
+	}
+
+	if priorHasOffset && currentHasOffset {
+		return prior.Equal(current), diags
+	}
+	return prior.Format(localDateTimeLayout) == current.Format(localDateTimeLayout) &&
+		prior.Nanosecond() == current.Nanosecond(), diags
+}
+
+// parseDateTime parses an RFC3339 date-time, with or without a UTC offset, and
+// reports whether it had one.
+func parseDateTime(s string) (time.Time, bool, error) {
+	if t, err := time.Parse(time.RFC3339, s); err == nil {
+		return t, true, nil
+	}
+	t, err := time.Parse(localDateTimeLayout, s)
+	return t, false, err
+}
+
+// inTimeZone re-expresses an RFC3339 date-time in the named IANA time zone,
+// e.g. "2023-12-28T01:00:00Z" in America/New_York as
+// "2023-12-27T20:00:00-05:00". It returns s unchanged if either can't be
+// parsed.
+func inTimeZone(s, timezone string) string {
+	t, err := time.Parse(time.RFC3339, s)
+	if err != nil || timezone == "" {
+		return s
+	}
+	loc, err := time.LoadLocation(timezone)
+	if err != nil {
+		return s
+	}
+	return t.In(loc).Format(time.RFC3339)
+}
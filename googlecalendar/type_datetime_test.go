@@ -0,0 +1,39 @@
+package googlecalendar
+
+import (
+	"context"
+	"testing"
+)
+
+func TestDateTimeValueStringSemanticEquals(t *testing.T) {
+	cases := []struct {
+		prior, current string
+		want           bool
+	}{
+		// Configured without an offset, read back in the event's time zone
+		{"2023-12-27T20:00:00", "2023-12-27T20:00:00-05:00", true},
+		{"2023-12-27T20:00:00", "2023-12-27T21:00:00-05:00", false},
+		// The same instant written with different offsets
+		{"2023-12-27T20:00:00-05:00", "2023-12-28T01:00:00Z", true},
+		{"2023-12-27T20:00:00-05:00", "2023-12-27T20:00:00-04:00", false},
+		{"2023-12-27T20:00:00", "not a date", false},
+	}
+
+	for _, tc := range cases {
+		got, diags := newDateTimeValue(tc.prior).StringSemanticEquals(context.Background(), newDateTimeValue(tc.current))
+		if diags.HasError() {
+			t.Fatalf("%s vs %s: unexpected diagnostics: %v", tc.prior, tc.current, diags)
+		}
+		if got != tc.want {
+			t.Errorf("%s vs %s: got %t, want %t", tc.prior, tc.current, got, tc.want)
+		}
+	}
+}
+
+func TestInTimeZone(t *testing.T) {
+	// The API reports times in the calendar's time zone, not the event's
+	got := inTimeZone("2023-12-27T17:00:00-08:00", "America/New_York")
+	if want := "2023-12-27T20:00:00-05:00"; got != want {
+		t.Errorf("got %s, want %s", got, want)
+	}
+}
@@ -0,0 +1,104 @@
+package googlecalendar
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/types/basetypes"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+)
+
+// Ensure the implementations satisfy the expected interfaces.
+var (
+	_ basetypes.StringTypable                    = emailType{}
+	_ basetypes.StringValuableWithSemanticEquals = emailValue{}
+)
+
+// emailType is a string type for email addresses. Google lower-cases the
+// addresses it hands back, so values that differ only in case are
+// semantically equal and the configured spelling is kept in state.
+type emailType struct {
+	basetypes.StringType
+}
+
+// Equal returns true if the given type is equivalent.
+func (t emailType) Equal(o attr.Type) bool {
+	other, ok := o.(emailType)
+	if !ok {
+		return false
+	}
+	return t.StringType.Equal(other.StringType)
+}
+
+// String returns a human readable string of the type name.
+func (t emailType) String() string {
+	return "emailType"
+}
+
+// ValueFromString returns a StringValuable type given a StringValue.
+func (t emailType) ValueFromString(ctx context.Context, in basetypes.StringValue) (basetypes.StringValuable, diag.Diagnostics) {
+	return emailValue{StringValue: in}, nil
+}
+
+// ValueFromTerraform returns a Value given a tftypes.Value.
+func (t emailType) ValueFromTerraform(ctx context.Context, in tftypes.Value) (attr.Value, error) {
+	attrValue, err := t.StringType.ValueFromTerraform(ctx, in)
+	if err != nil {
+		return nil, err
+	}
+
+	stringValue, ok := attrValue.(basetypes.StringValue)
+	if !ok {
+		return nil, fmt.Errorf("unexpected value type of %T", attrValue)
+	}
+
+	return emailValue{StringValue: stringValue}, nil
+}
+
+// ValueType returns the Value type.
+func (t emailType) ValueType(ctx context.Context) attr.Value {
+	return emailValue{}
+}
+
+// emailValue is a value of emailType.
+type emailValue struct {
+	basetypes.StringValue
+}
+
+// newEmailValue returns a known emailValue.
+func newEmailValue(s string) emailValue {
+	return emailValue{StringValue: basetypes.NewStringValue(s)}
+}
+
+// Equal returns true if the given value is equivalent.
+func (v emailValue) Equal(o attr.Value) bool {
+	other, ok := o.(emailValue)
+	if !ok {
+		return false
+	}
+	return v.StringValue.Equal(other.StringValue)
+}
+
+// Type returns the value's type.
+func (v emailValue) Type(ctx context.Context) attr.Type {
+	return emailType{}
+}
+
+// StringSemanticEquals returns true if the two addresses differ only in case.
+func (v emailValue) StringSemanticEquals(ctx context.Context, newValuable basetypes.StringValuable) (bool, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	newValue, ok := newValuable.(emailValue)
+	if !ok {
+		diags.AddError(
+			"Semantic Equality Check Error",
+			fmt.Sprintf("Expected value type %T but got value type %T.", v, newValuable),
+		)
+		return false, diags
+	}
+
+	return strings.EqualFold(v.ValueString(), newValue.ValueString()), diags
+}
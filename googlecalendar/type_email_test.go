@@ -0,0 +1,27 @@
+package googlecalendar
+
+import (
+	"context"
+	"testing"
+)
+
+func TestEmailValueStringSemanticEquals(t *testing.T) {
+	cases := []struct {
+		prior, proposed string
+		want            bool
+	}{
+		{"First.Last@Domain.com", "first.last@domain.com", true},
+		{"me@domain.com", "me@domain.com", true},
+		{"flast@domain.com", "first.last@domain.com", false},
+	}
+
+	for _, c := range cases {
+		got, diags := newEmailValue(c.prior).StringSemanticEquals(context.Background(), newEmailValue(c.proposed))
+		if diags.HasError() {
+			t.Fatalf("unexpected diagnostics: %v", diags)
+		}
+		if got != c.want {
+			t.Errorf("%q vs %q: got %t, want %t", c.prior, c.proposed, got, c.want)
+		}
+	}
+}
@@ -0,0 +1,210 @@
+package googlecalendar
+
+import (
+	"context"
+	"fmt"
+	"slices"
+	"strings"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-framework/types/basetypes"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+	"github.com/teambition/rrule-go"
+)
+
+// Ensure the implementations satisfy the expected interfaces.
+var (
+	_ basetypes.ListTypable                    = recurrenceType{}
+	_ basetypes.ListValuableWithSemanticEquals = recurrenceValue{}
+)
+
+// recurrenceType is a list of strings type for an event's recurrence lines.
+// Google rewrites the lines it's given - reordering RRULE parts, adding WKST,
+// reformatting UNTIL and EXDATE values - so recurrences describing the same
+// schedule are semantically equal and the configured lines are kept in state.
+type recurrenceType struct {
+	basetypes.ListType
+}
+
+// newRecurrenceType returns a recurrenceType of strings.
+func newRecurrenceType() recurrenceType {
+	return recurrenceType{ListType: basetypes.ListType{ElemType: types.StringType}}
+}
+
+// Equal returns true if the given type is equivalent.
+func (t recurrenceType) Equal(o attr.Type) bool {
+	other, ok := o.(recurrenceType)
+	if !ok {
+		return false
+	}
+	return t.ListType.Equal(other.ListType)
+}
+
+// String returns a human readable string of the type name.
+func (t recurrenceType) String() string {
+	return "recurrenceType"
+}
+
+// ValueFromList returns a ListValuable type given a ListValue.
+func (t recurrenceType) ValueFromList(ctx context.Context, in basetypes.ListValue) (basetypes.ListValuable, diag.Diagnostics) {
+	return recurrenceValue{ListValue: in}, nil
+}
+
+// ValueFromTerraform returns a Value given a tftypes.Value.
+func (t recurrenceType) ValueFromTerraform(ctx context.Context, in tftypes.Value) (attr.Value, error) {
+	attrValue, err := t.ListType.ValueFromTerraform(ctx, in)
+	if err != nil {
+		return nil, err
+	}
+
+	listValue, ok := attrValue.(basetypes.ListValue)
+	if !ok {
+		return nil, fmt.Errorf("unexpected value type of %T", attrValue)
+	}
+
+	return recurrenceValue{ListValue: listValue}, nil
+}
+
+// ValueType returns the Value type.
+func (t recurrenceType) ValueType(ctx context.Context) attr.Value {
+	return recurrenceValue{}
+}
+
+// recurrenceValue is a value of recurrenceType.
+type recurrenceValue struct {
+	basetypes.ListValue
+}
+
+// newRecurrenceValue returns a known recurrenceValue holding lines.
+func newRecurrenceValue(lines []string) recurrenceValue {
+	elements := make([]attr.Value, len(lines))
+	for i, line := range lines {
+		elements[i] = types.StringValue(line)
+	}
+	return recurrenceValue{ListValue: basetypes.NewListValueMust(types.StringType, elements)}
+}
+
+// newRecurrenceNull returns a null recurrenceValue.
+func newRecurrenceNull() recurrenceValue {
+	return recurrenceValue{ListValue: basetypes.NewListNull(types.StringType)}
+}
+
+// Equal returns true if the given value is equivalent.
+func (v recurrenceValue) Equal(o attr.Value) bool {
+	other, ok := o.(recurrenceValue)
+	if !ok {
+		return false
+	}
+	return v.ListValue.Equal(other.ListValue)
+}
+
+// Type returns the value's type.
+func (v recurrenceValue) Type(ctx context.Context) attr.Type {
+	return newRecurrenceType()
+}
+
+// ListSemanticEquals returns true if the two recurrences describe the same
+// schedule, as decided by canonicalRecurrence.
+func (v recurrenceValue) ListSemanticEquals(ctx context.Context, newValuable basetypes.ListValuable) (bool, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	newValue, ok := newValuable.(recurrenceValue)
+	if !ok {
+		diags.AddError(
+			"Semantic Equality Check Error",
+			fmt.Sprintf("Expected value type %T but got value type %T.", v, newValuable),
+		)
+		return false, diags
+	}
+
+	var prior, current []string
+	diags = append(diags, v.ElementsAs(ctx, &prior, false)...)
+	diags = append(diags, newValue.ElementsAs(ctx, &current, false)...)
+	if diags.HasError() {
+		return false, diags
+	}
+
+	priorCanonical, err := canonicalRecurrence(prior)
+	if err != nil {
+		return false, diags
+	}
+	currentCanonical, err := canonicalRecurrence(current)
+	if err != nil {
+		return false, diags
+	}
+
+	return slices.Equal(priorCanonical, currentCanonical), diags
+}
+
+// canonicalRecurrence reduces recurrence lines to a sorted canonical form, so
+// two recurrences can be compared regardless of how they were written. Rules
+// are parsed with rrule-go and written back with their parts - and the values
+// within each part - sorted, dropping INTERVAL=1 and any WKST that doesn't
+// change the rule's occurrences. RDATE and EXDATE lines are split into one
+// entry per date, in UTC.
+func canonicalRecurrence(lines []string) ([]string, error) {
+	var canonical []string
+
+	for _, line := range lines {
+		name, value, err := splitRecurrenceLine(line)
+		if err != nil {
+			return nil, err
+		}
+
+		switch name {
+		case "RRULE", "EXRULE":
+			opt, err := rrule.StrToROption(value[1:])
+			if err != nil {
+				return nil, fmt.Errorf("parsing %q: %w", line, err)
+			}
+			if opt.Interval == 1 {
+				opt.Interval = 0
+			}
+			if !(opt.Freq == rrule.WEEKLY && opt.Interval > 1) && len(opt.Byweekno) == 0 {
+				opt.Wkst = rrule.MO
+			}
+
+			parts := strings.Split(opt.RRuleString(), ";")
+			for i, part := range parts {
+				key, values, _ := strings.Cut(part, "=")
+				sorted := strings.Split(values, ",")
+				slices.Sort(sorted)
+				parts[i] = key + "=" + strings.Join(sorted, ",")
+			}
+			slices.Sort(parts)
+			canonical = append(canonical, name+":"+strings.Join(parts, ";"))
+
+		case "RDATE", "EXDATE":
+			dates, err := rrule.StrToDatesInLoc(value[1:], time.UTC)
+			if err != nil {
+				return nil, fmt.Errorf("parsing %q: %w", line, err)
+			}
+			for _, date := range dates {
+				canonical = append(canonical, name+":"+date.UTC().Format("20060102T150405Z"))
+			}
+
+		default:
+			return nil, fmt.Errorf("unsupported recurrence line %q", line)
+		}
+	}
+
+	slices.Sort(canonical)
+	return canonical, nil
+}
+
+// splitRecurrenceLine splits a recurrence line into its upper-cased name -
+// RRULE, EXDATE and so on - and the rest of the line, which starts with the
+// ";" of its parameters or the ":" before its value.
+func splitRecurrenceLine(line string) (string, string, error) {
+	line = strings.TrimSpace(line)
+
+	i := strings.IndexAny(line, ";:")
+	if i <= 0 {
+		return "", "", fmt.Errorf("malformed recurrence line %q", line)
+	}
+
+	return strings.ToUpper(line[:i]), line[i:], nil
+}
@@ -0,0 +1,70 @@
+package googlecalendar
+
+import (
+	"context"
+	"testing"
+)
+
+func TestRecurrenceValueListSemanticEquals(t *testing.T) {
+	cases := []struct {
+		name           string
+		prior, current []string
+		want           bool
+	}{
+		{
+			name:    "reordered parts and days",
+			prior:   []string{"RRULE:FREQ=WEEKLY;BYDAY=MO,FR;INTERVAL=1"},
+			current: []string{"RRULE:BYDAY=FR,MO;FREQ=WEEKLY"},
+			want:    true,
+		},
+		{
+			name:    "WKST that doesn't change the schedule",
+			prior:   []string{"RRULE:FREQ=WEEKLY;BYDAY=TU"},
+			current: []string{"RRULE:FREQ=WEEKLY;WKST=SU;BYDAY=TU"},
+			want:    true,
+		},
+		{
+			name:    "WKST on a biweekly rule",
+			prior:   []string{"RRULE:FREQ=WEEKLY;INTERVAL=2;BYDAY=TU,SU"},
+			current: []string{"RRULE:FREQ=WEEKLY;INTERVAL=2;WKST=SU;BYDAY=TU,SU"},
+			want:    false,
+		},
+		{
+			name:    "reformatted UNTIL",
+			prior:   []string{"RRULE:FREQ=DAILY;UNTIL=20260101"},
+			current: []string{"RRULE:FREQ=DAILY;UNTIL=20260101T000000Z"},
+			want:    true,
+		},
+		{
+			name: "EXDATE split and moved to UTC",
+			prior: []string{
+				"RRULE:FREQ=WEEKLY",
+				"EXDATE;TZID=America/New_York:20261225T143000,20270101T143000",
+			},
+			current: []string{
+				"EXDATE:20261225T193000Z",
+				"EXDATE:20270101T193000Z",
+				"RRULE:FREQ=WEEKLY",
+			},
+			want: true,
+		},
+		{
+			name:    "different frequency",
+			prior:   []string{"RRULE:FREQ=WEEKLY"},
+			current: []string{"RRULE:FREQ=DAILY"},
+			want:    false,
+		},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			got, diags := newRecurrenceValue(tc.prior).ListSemanticEquals(context.Background(), newRecurrenceValue(tc.current))
+			if diags.HasError() {
+				t.Fatalf("unexpected diagnostics: %v", diags)
+			}
+			if got != tc.want {
+				t.Errorf("got %t, want %t", got, tc.want)
+			}
+		})
+	}
+}
@@ -0,0 +1,160 @@
+package googlecalendar
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/teambition/rrule-go"
+)
+
+// Ensure the implementations satisfy the expected interfaces.
+var (
+	_ validator.String = durationValidator{}
+	_ validator.List   = recurrenceValidator{}
+	_ validator.String = dateValidator{}
+)
+
+// durationValidator checks that a string parses with time.ParseDuration and
+// is positive.
+type durationValidator struct{}
+
+// Description describes the validation in plain text formatting.
+func (v durationValidator) Description(ctx context.Context) string {
+	return "value must be a positive duration, such as \"90s\" or \"5m\""
+}
+
+// MarkdownDescription describes the validation in Markdown formatting.
+func (v durationValidator) MarkdownDescription(ctx context.Context) string {
+	return "value must be a positive duration, such as `90s` or `5m`"
+}
+
+// ValidateString performs the validation.
+func (v durationValidator) ValidateString(ctx context.Context, req validator.StringRequest, resp *validator.StringResponse) {
+	if req.ConfigValue.IsNull() || req.ConfigValue.IsUnknown() {
+		return
+	}
+
+	d, err := time.ParseDuration(req.ConfigValue.ValueString())
+	if err != nil || d <= 0 {
+		resp.Diagnostics.AddAttributeError(
+			req.Path,
+			"Invalid Duration",
+			fmt.Sprintf("%s, got: %q", v.Description(ctx), req.ConfigValue.ValueString()),
+		)
+	}
+}
+
+// recurrenceValidator checks that each recurrence line parses as an RFC 5545
+// RRULE, EXRULE, RDATE or EXDATE, so mistakes surface at plan time rather than
+// from the API mid-apply - or not at all until a destroy tries to expand the
+// rule. It also rejects rules Google would refuse or misread: COUNT together
+// with UNTIL, and an UNTIL in local time rather than UTC.
+type recurrenceValidator struct{}
+
+// Description describes the validation in plain text formatting.
+func (v recurrenceValidator) Description(ctx context.Context) string {
+	return "each line must be a valid RRULE, EXRULE, RDATE or EXDATE"
+}
+
+// MarkdownDescription describes the validation in Markdown formatting.
+func (v recurrenceValidator) MarkdownDescription(ctx context.Context) string {
+	return "each line must be a valid `RRULE`, `EXRULE`, `RDATE` or `EXDATE`"
+}
+
+// ValidateList performs the validation.
+func (v recurrenceValidator) ValidateList(ctx context.Context, req validator.ListRequest, resp *validator.ListResponse) {
+	if req.ConfigValue.IsNull() || req.ConfigValue.IsUnknown() {
+		return
+	}
+
+	for i, element := range req.ConfigValue.Elements() {
+		line, ok := element.(types.String)
+		if !ok || line.IsNull() || line.IsUnknown() {
+			continue
+		}
+
+		if err := validateRecurrenceLine(line.ValueString()); err != nil {
+			resp.Diagnostics.AddAttributeError(
+				req.Path.AtListIndex(i),
+				"Invalid Recurrence",
+				fmt.Sprintf("%s: %s", v.Description(ctx), err),
+			)
+		}
+	}
+}
+
+// validateRecurrenceLine returns what's wrong with a single recurrence line,
+// if anything.
+func validateRecurrenceLine(line string) error {
+	name, value, err := splitRecurrenceLine(line)
+	if err != nil {
+		return err
+	}
+
+	switch name {
+	case "RRULE", "EXRULE":
+		// Part names are case-insensitive
+		var count, until bool
+		for _, part := range strings.Split(value[1:], ";") {
+			name, v, _ := strings.Cut(part, "=")
+			switch strings.ToUpper(name) {
+			case "COUNT":
+				count = true
+			case "UNTIL":
+				until = true
+				if strings.ContainsAny(v, "Tt") && !strings.HasSuffix(strings.ToUpper(v), "Z") {
+					return fmt.Errorf("%q has an UNTIL in local time; Google requires it in UTC, "+
+						"written with a trailing Z", line)
+				}
+			}
+		}
+		if count && until {
+			return fmt.Errorf("%q sets both COUNT and UNTIL; use one or the other", line)
+		}
+		if _, err := rrule.StrToROption(value[1:]); err != nil {
+			return fmt.Errorf("%q: %w", line, err)
+		}
+
+	case "RDATE", "EXDATE":
+		if _, err := rrule.StrToDatesInLoc(value[1:], time.UTC); err != nil {
+			return fmt.Errorf("%q: %w", line, err)
+		}
+
+	default:
+		return fmt.Errorf("%q starts with %s; only RRULE, EXRULE, RDATE and EXDATE are supported", line, name)
+	}
+
+	return nil
+}
+
+// dateValidator checks that a string is a date in YYYY-MM-DD form.
+type dateValidator struct{}
+
+// Description describes the validation in plain text formatting.
+func (v dateValidator) Description(ctx context.Context) string {
+	return "value must be a date in YYYY-MM-DD form, such as \"2026-12-25\""
+}
+
+// MarkdownDescription describes the validation in Markdown formatting.
+func (v dateValidator) MarkdownDescription(ctx context.Context) string {
+	return "value must be a date in `YYYY-MM-DD` form, such as `2026-12-25`"
+}
+
+// ValidateString performs the validation.
+func (v dateValidator) ValidateString(ctx context.Context, req validator.StringRequest, resp *validator.StringResponse) {
+	if req.ConfigValue.IsNull() || req.ConfigValue.IsUnknown() {
+		return
+	}
+
+	if _, err := time.Parse(time.DateOnly, req.ConfigValue.ValueString()); err != nil {
+		resp.Diagnostics.AddAttributeError(
+			req.Path,
+			"Invalid Date",
+			fmt.Sprintf("%s, got: %q", v.Description(ctx), req.ConfigValue.ValueString()),
+		)
+	}
+}
@@ -0,0 +1,282 @@
+package googlecalendar
+
+import (
+	"context"
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+)
+
+func TestValidateRecurrenceLine(t *testing.T) {
+	cases := []struct {
+		line  string
+		valid bool
+		// wantErr, if set, is part of the expected error
+		wantErr string
+	}{
+		{"RRULE:FREQ=WEEKLY;BYDAY=MO", true, ""},
+		{"RRULE:FREQ=DAILY;UNTIL=20261231T235959Z", true, ""},
+		{"EXRULE:FREQ=MONTHLY;BYMONTHDAY=1", true, ""},
+		{"EXDATE;TZID=America/New_York:20261225T143000", true, ""},
+		{"RDATE:20261226T193000Z,20261227T193000Z", true, ""},
+		{"RRULE:FREQ=FORTNIGHTLY", false, ""},
+		{"RRULE:FREQ=DAILY;COUNT=5;UNTIL=20261231T235959Z", false, "both COUNT and UNTIL"},
+		{"RRULE:FREQ=DAILY;UNTIL=20261231T235959", false, "local time"},
+		{"rrule:freq=daily;count=5;until=20261231T235959Z", false, "both COUNT and UNTIL"},
+		{"RRULE:FREQ=DAILY;until=20261231T235959", false, "local time"},
+		{"DTSTART:20261201T143000Z", false, ""},
+		{"EXDATE;TZID=Not/AZone:20261225T143000", false, ""},
+		{"FREQ=WEEKLY", false, ""},
+	}
+
+	for _, tc := range cases {
+		err := validateRecurrenceLine(tc.line)
+		if tc.valid && err != nil {
+			t.Errorf("%s: unexpected error: %v", tc.line, err)
+		}
+		if !tc.valid && err == nil {
+			t.Errorf("%s: expected an error", tc.line)
+		}
+		if err != nil && !strings.Contains(err.Error(), tc.wantErr) {
+			t.Errorf("%s: got error %v, want one about %s", tc.line, err, tc.wantErr)
+		}
+	}
+}
+
+func TestEventValidateConfig(t *testing.T) {
+	ctx := context.Background()
+	s := eventSchema(t)
+	typ := s.Type().TerraformType(ctx).(tftypes.Object)
+	str := func(v string) tftypes.Value { return tftypes.NewValue(tftypes.String, v) }
+	conference := func(attrs map[string]tftypes.Value) tftypes.Value {
+		return objectValue(typ.AttributeTypes["conference"], attrs)
+	}
+
+	cases := []struct {
+		name    string
+		attrs   map[string]tftypes.Value
+		wantErr string // path of the expected error, if any
+	}{
+		{
+			name: "valid",
+			attrs: map[string]tftypes.Value{
+				"start":    str("2026-01-05T09:00:00"),
+				"end":      str("2026-01-05T09:30:00"),
+				"timezone": str("America/New_York"),
+			},
+		},
+		{
+			name: "unknown time zone",
+			attrs: map[string]tftypes.Value{
+				"start":    str("2026-01-05T09:00:00"),
+				"end":      str("2026-01-05T09:30:00"),
+				"timezone": str("America/Nowhere"),
+			},
+			wantErr: "timezone",
+		},
+		{
+			name: "empty time zone",
+			attrs: map[string]tftypes.Value{
+				"timezone": str(""),
+			},
+			wantErr: "timezone",
+		},
+		{
+			name: "unparseable start",
+			attrs: map[string]tftypes.Value{
+				"start": str("next tuesday"),
+				"end":   str("2026-01-05T09:30:00Z"),
+			},
+			wantErr: "start",
+		},
+		{
+			name: "end before start",
+			attrs: map[string]tftypes.Value{
+				"start": str("2026-01-05T09:30:00Z"),
+				"end":   str("2026-01-05T09:00:00Z"),
+			},
+			wantErr: "end",
+		},
+		{
+			name: "end equal to start",
+			attrs: map[string]tftypes.Value{
+				"start": str("2026-01-05T09:00:00Z"),
+				"end":   str("2026-01-05T09:00:00Z"),
+			},
+			wantErr: "end",
+		},
+		{
+			// 14:30 in New York is after 15:00 in London
+			name: "offsets and time zone compared as instants",
+			attrs: map[string]tftypes.Value{
+				"start":    str("2026-01-05T15:00:00+00:00"),
+				"end":      str("2026-01-05T14:30:00"),
+				"timezone": str("America/New_York"),
+			},
+		},
+		{
+			name: "ends_on after start",
+			attrs: map[string]tftypes.Value{
+				"start":      str("2026-01-05T09:00:00"),
+				"end":        str("2026-01-05T09:30:00"),
+				"timezone":   str("America/New_York"),
+				"recurrence": tftypes.NewValue(tftypes.List{ElementType: tftypes.String}, []tftypes.Value{str("RRULE:FREQ=DAILY")}),
+				"ends_on":    str("2026-01-06"),
+			},
+		},
+		{
+			name: "ends_on on the start day",
+			attrs: map[string]tftypes.Value{
+				"start":      str("2026-01-05T09:00:00"),
+				"end":        str("2026-01-05T09:30:00"),
+				"timezone":   str("America/New_York"),
+				"recurrence": tftypes.NewValue(tftypes.List{ElementType: tftypes.String}, []tftypes.Value{str("RRULE:FREQ=DAILY")}),
+				"ends_on":    str("2026-01-05"),
+			},
+			wantErr: "ends_on",
+		},
+		{
+			name: "ends_on before start",
+			attrs: map[string]tftypes.Value{
+				"start":      str("2026-01-05T09:00:00"),
+				"end":        str("2026-01-05T09:30:00"),
+				"recurrence": tftypes.NewValue(tftypes.List{ElementType: tftypes.String}, []tftypes.Value{str("RRULE:FREQ=DAILY")}),
+				"ends_on":    str("2025-12-31"),
+			},
+			wantErr: "ends_on",
+		},
+		{
+			// 02:00 UTC on the 6th is still the 5th in New York
+			name: "ends_on compared in the event's time zone",
+			attrs: map[string]tftypes.Value{
+				"start":      str("2026-01-06T02:00:00Z"),
+				"end":        str("2026-01-06T02:30:00Z"),
+				"timezone":   str("America/New_York"),
+				"recurrence": tftypes.NewValue(tftypes.List{ElementType: tftypes.String}, []tftypes.Value{str("RRULE:FREQ=DAILY")}),
+				"ends_on":    str("2026-01-06"),
+			},
+		},
+		{
+			name: "skip without recurrence",
+			attrs: map[string]tftypes.Value{
+				"skip": tftypes.NewValue(typ.AttributeTypes["skip"], []tftypes.Value{
+					objectValue(typ.AttributeTypes["skip"].(tftypes.Set).ElementType, map[string]tftypes.Value{
+						"date": str("2026-12-24"),
+					}),
+				}),
+			},
+			wantErr: "skip",
+		},
+		{
+			name: "skip with recurrence",
+			attrs: map[string]tftypes.Value{
+				"recurrence": tftypes.NewValue(tftypes.List{ElementType: tftypes.String}, []tftypes.Value{str("RRULE:FREQ=DAILY")}),
+				"skip": tftypes.NewValue(typ.AttributeTypes["skip"], []tftypes.Value{
+					objectValue(typ.AttributeTypes["skip"].(tftypes.Set).ElementType, map[string]tftypes.Value{
+						"date": str("2026-12-24"),
+					}),
+				}),
+			},
+		},
+		{
+			name: "valid Meet ID",
+			attrs: map[string]tftypes.Value{
+				"conference": conference(map[string]tftypes.Value{"conference_id": str("aaa-bbbb-ccc")}),
+			},
+		},
+		{
+			name: "invalid Meet ID",
+			attrs: map[string]tftypes.Value{
+				"conference": conference(map[string]tftypes.Value{"conference_id": str("not-a-meet")}),
+			},
+			wantErr: "conference.conference_id",
+		},
+		{
+			name: "other solutions' IDs aren't checked",
+			attrs: map[string]tftypes.Value{
+				"conference": conference(map[string]tftypes.Value{
+					"solution_type": str("addOn"),
+					"conference_id": str("123456789"),
+				}),
+			},
+		},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			checkValidateConfig(t, &eventResource{}, tc.attrs, tc.wantErr)
+		})
+	}
+}
+
+// eventTimeCases are the ValidateConfig cases every event resource shares,
+// checked by validateEventTime.
+var eventTimeCases = []struct {
+	name    string
+	attrs   map[string]tftypes.Value
+	wantErr string
+}{
+	{
+		name: "valid",
+		attrs: map[string]tftypes.Value{
+			"start":    tftypes.NewValue(tftypes.String, "2026-01-05T09:00:00"),
+			"end":      tftypes.NewValue(tftypes.String, "2026-01-05T17:00:00"),
+			"timezone": tftypes.NewValue(tftypes.String, "Europe/Paris"),
+		},
+	},
+	{
+		name: "unknown time zone",
+		attrs: map[string]tftypes.Value{
+			"timezone": tftypes.NewValue(tftypes.String, "Europe/Nowhere"),
+		},
+		wantErr: "timezone",
+	},
+	{
+		name: "unparseable end",
+		attrs: map[string]tftypes.Value{
+			"start": tftypes.NewValue(tftypes.String, "2026-01-05T09:00:00Z"),
+			"end":   tftypes.NewValue(tftypes.String, "tomorrow"),
+		},
+		wantErr: "end",
+	},
+	{
+		name: "end before start",
+		attrs: map[string]tftypes.Value{
+			"start": tftypes.NewValue(tftypes.String, "2026-01-05T17:00:00Z"),
+			"end":   tftypes.NewValue(tftypes.String, "2026-01-05T09:00:00Z"),
+		},
+		wantErr: "end",
+	},
+}
+
+// checkValidateConfig runs r's ValidateConfig against a configuration with
+// the given attributes, and every other attribute null, and checks that it
+// reports one error at the path wantErr, or none if wantErr is empty.
+func checkValidateConfig(t *testing.T, r resource.ResourceWithValidateConfig, attrs map[string]tftypes.Value, wantErr string) {
+	t.Helper()
+	ctx := context.Background()
+
+	var schemaResp resource.SchemaResponse
+	r.Schema(ctx, resource.SchemaRequest{}, &schemaResp)
+	s := schemaResp.Schema
+	req := resource.ValidateConfigRequest{Config: tfsdk.Config{Schema: s, Raw: objectValue(s.Type().TerraformType(ctx), attrs)}}
+	resp := &resource.ValidateConfigResponse{}
+	r.ValidateConfig(ctx, req, resp)
+
+	if wantErr == "" {
+		if resp.Diagnostics.HasError() {
+			t.Errorf("unexpected diagnostics: %v", resp.Diagnostics)
+		}
+		return
+	}
+	if resp.Diagnostics.ErrorsCount() != 1 {
+		t.Fatalf("got diagnostics %v, want one error", resp.Diagnostics)
+	}
+	if got, ok := resp.Diagnostics.Errors()[0].(diag.DiagnosticWithPath); !ok || got.Path().String() != wantErr {
+		t.Errorf("got error %v, want one at %s", resp.Diagnostics.Errors()[0], wantErr)
+	}
+}